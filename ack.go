@@ -0,0 +1,44 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ACK acknowledges an alarm reported by ALR
+type ACK struct {
+	Talker string `csv:"talker"` // talker ID, defaults to II (integrated instrumentation) when serializing
+	ID     int    `csv:"id"`     // alarm identifier, 000 to 999
+}
+
+// Type returns TypeACK to fulfill the Sentence interface
+func (a ACK) Type() Type {
+	return TypeACK
+}
+
+// String will provide a NMEA formatted string
+func (a ACK) String() string {
+	return Raw{
+		TypeName: typeName(a.Talker, "II", TypeACK),
+		Fields:   []string{fmt.Sprintf("%03d", a.ID)},
+	}.String()
+}
+
+// Parse will parse ACK data from a raw sentence struct
+func (a *ACK) Parse(r *Raw) error {
+	var err error
+	a.Talker, err = parseTalker(r, TypeACK)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 1 {
+		return fmt.Errorf("not enough fields, need at least 1")
+	}
+
+	a.ID, err = strconv.Atoi(r.Fields[0])
+	if err != nil {
+		return fmt.Errorf("parse ID: %s", err)
+	}
+
+	return nil
+}
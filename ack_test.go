@@ -0,0 +1,27 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ackStr = "$IIACK,031*57"
+
+func TestACK_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(ackStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := new(ACK)
+	err = a.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeACK, a.Type(), "type")
+	assert.Equal(t, 31, a.ID, "ID")
+}
+
+func TestACK_String(t *testing.T) {
+	assert.Equal(t, "$IIACK,007*52", ACK{ID: 7}.String())
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ACNCommand is the command sent by an ACN sentence
+type ACNCommand string
+
+// Commands for ACN
+const (
+	ACNCommandAcknowledge ACNCommand = "A"
+	ACNCommandRequest     ACNCommand = "Q" // request or repeat information
+	ACNCommandTransfer    ACNCommand = "O" // responsibility transfer
+	ACNCommandSilence     ACNCommand = "S" // silence for 30 seconds
+)
+
+// ACN sends an alert command, such as an acknowledgment, as part of bridge alert management
+type ACN struct {
+	Talker       string     `csv:"talker"`       // talker ID, defaults to II (integrated instrumentation) when serializing
+	Time         time.Time  `csv:"time"`         // time of the command
+	Manufacturer string     `csv:"manufacturer"` // manufacturer mnemonic code, empty for standardized alerts
+	ID           int        `csv:"id"`           // alert identifier
+	Instance     int        `csv:"instance"`     // alert instance
+	Command      ACNCommand `csv:"command"`      // command to perform
+}
+
+// Type returns TypeACN to fulfill the Sentence interface
+func (a ACN) Type() Type {
+	return TypeACN
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (a ACN) String() string {
+	return Raw{
+		TypeName: typeName(a.Talker, "II", TypeACN),
+		Fields: []string{
+			a.Time.Format(timeFormat),
+			a.Manufacturer,
+			strconv.Itoa(a.ID),
+			strconv.Itoa(a.Instance),
+			string(a.Command),
+			"C",
+		},
+	}.String()
+}
+
+// Parse will parse ACN data from a raw sentence struct
+func (a *ACN) Parse(r *Raw) error {
+	var err error
+	a.Talker, err = parseTalker(r, TypeACN)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	if r.Fields[0] != "" {
+		a.Time, err = time.ParseInLocation(timeFormat, r.Fields[0], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	} else {
+		a.Time = time.Time{}
+	}
+
+	a.Manufacturer = r.Fields[1]
+
+	a.ID, err = parseFieldInt(r.Fields[2], "ID")
+	if err != nil {
+		return err
+	}
+	a.Instance, err = parseFieldInt(r.Fields[3], "Instance")
+	if err != nil {
+		return err
+	}
+
+	switch ACNCommand(r.Fields[4]) {
+	case ACNCommandAcknowledge, ACNCommandRequest, ACNCommandTransfer, ACNCommandSilence:
+		a.Command = ACNCommand(r.Fields[4])
+	default:
+		return fmt.Errorf("invalid command: %s", r.Fields[4])
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const acnStr = "$IIACN,013700.00,,192,1,A,C*6E"
+
+func TestACN_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(acnStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := new(ACN)
+	err = a.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeACN, a.Type(), "type")
+	assert.Equal(t, "013700", a.Time.Format(timeFormat), "timestamp")
+	assert.Equal(t, "", a.Manufacturer, "manufacturer")
+	assert.Equal(t, 192, a.ID, "ID")
+	assert.Equal(t, 1, a.Instance, "instance")
+	assert.Equal(t, ACNCommandAcknowledge, a.Command, "command")
+}
+
+func TestACN_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := ACN{Time: tm, Manufacturer: "XYZ", ID: 3001, Instance: 2, Command: ACNCommandSilence}.String()
+
+	assert.Equal(t, "$IIACN,040506,XYZ,3001,2,S,C*30", str)
+}
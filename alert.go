@@ -0,0 +1,230 @@
+package nmea
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AlertState is the simplified state of an alert tracked by an AlertManager
+type AlertState int
+
+// Alert states
+const (
+	AlertStateActive       AlertState = iota // the alert condition is present, or needs acknowledgment
+	AlertStateAcknowledged                   // the alert condition is present and has been acknowledged
+	AlertStateCleared                        // the alert condition is no longer present
+)
+
+func (s AlertState) String() string {
+	switch s {
+	case AlertStateActive:
+		return "active"
+	case AlertStateAcknowledged:
+		return "acknowledged"
+	case AlertStateCleared:
+		return "cleared"
+	default:
+		return fmt.Sprintf("AlertState(%d)", int(s))
+	}
+}
+
+// AlertID identifies an alert. Alerts from ALR only have an Identifier
+type AlertID struct {
+	Manufacturer string
+	Identifier   int
+	Instance     int
+}
+
+// Alert is the current state of an alert tracked by an AlertManager
+type Alert struct {
+	ID       AlertID
+	Talker   string      // talker that raised the alert
+	State    AlertState  // current state
+	Priority ALFPriority // alert priority, empty for alerts from ALR
+	Text     string      // alert description
+	Time     time.Time   // time of the last change in state, as reported by the sentence
+
+	bam bool // true if reported by ALF (bridge alert management) rather than ALR
+}
+
+// AlertManager tracks the state of alerts raised by ALR and ALF sentences, and the
+// acknowledgments sent by ACK and ACN.
+type AlertManager struct {
+	// KeepCleared is the number of the most recently cleared alerts that are kept.
+	// Older cleared alerts are removed, so by default an alert is forgotten once it is cleared.
+	KeepCleared int
+
+	alerts  map[AlertID]*Alert
+	seq     map[string]AlertID // talker and sequence identifier of multi-sentence ALF awaiting continued text
+	cleared []AlertID          // cleared alerts, in the order they were cleared
+}
+
+// Update will update alert state from the sentence. Unrelated sentences are ignored.
+func (m *AlertManager) Update(s Sentence) {
+	if m.alerts == nil {
+		m.alerts = make(map[AlertID]*Alert)
+		m.seq = make(map[string]AlertID)
+	}
+
+	switch v := s.(type) {
+	case *ALR:
+		a := m.alert(AlertID{Identifier: v.ID})
+		a.Talker = v.Talker
+		a.Text = v.Text
+		a.Time = v.Time
+		switch {
+		case !v.Active:
+			a.State = AlertStateCleared
+		case v.Acknowledged:
+			a.State = AlertStateAcknowledged
+		default:
+			a.State = AlertStateActive
+		}
+		m.retain(a)
+	case *ALF:
+		key := fmt.Sprintf("%s%d", v.Talker, v.Sequence)
+		if v.Number > 1 {
+			// continued text, ignored if the first sentence was not seen
+			id, ok := m.seq[key]
+			if !ok {
+				return
+			}
+			if v.Number >= v.Total {
+				delete(m.seq, key)
+			}
+			if a, ok := m.alerts[id]; ok {
+				a.Text += v.Text
+			}
+			return
+		}
+		id := AlertID{Manufacturer: v.Manufacturer, Identifier: v.ID, Instance: v.Instance}
+		if v.Total > 1 {
+			m.seq[key] = id
+		} else {
+			delete(m.seq, key)
+		}
+		a := m.alert(id)
+		a.bam = true
+		a.Talker = v.Talker
+		a.Priority = v.Priority
+		a.Text = v.Text
+		a.Time = v.Time
+		switch v.State {
+		case ALFStateActiveAcknowledged, ALFStateActiveTransferred:
+			a.State = AlertStateAcknowledged
+		case ALFStateNormal:
+			a.State = AlertStateCleared
+		default:
+			a.State = AlertStateActive
+		}
+		m.retain(a)
+	case *ACK:
+		m.acknowledge(AlertID{Identifier: v.ID})
+	case *ACN:
+		if v.Command == ACNCommandAcknowledge {
+			m.acknowledge(AlertID{Manufacturer: v.Manufacturer, Identifier: v.ID, Instance: v.Instance})
+		}
+	}
+}
+
+func (m *AlertManager) alert(id AlertID) *Alert {
+	a := m.alerts[id]
+	if a == nil {
+		a = &Alert{ID: id}
+		m.alerts[id] = a
+	}
+	return a
+}
+
+// retain will track a if it is cleared, removing the oldest cleared alerts beyond KeepCleared
+func (m *AlertManager) retain(a *Alert) {
+	for i, id := range m.cleared {
+		if id == a.ID {
+			m.cleared = append(m.cleared[:i], m.cleared[i+1:]...)
+			break
+		}
+	}
+	if a.State != AlertStateCleared {
+		return
+	}
+	m.cleared = append(m.cleared, a.ID)
+	for len(m.cleared) > m.KeepCleared {
+		delete(m.alerts, m.cleared[0])
+		m.cleared = m.cleared[1:]
+	}
+}
+
+func (m *AlertManager) acknowledge(id AlertID) {
+	a := m.alerts[id]
+	if a == nil || a.State != AlertStateActive {
+		return
+	}
+	a.State = AlertStateAcknowledged
+}
+
+// Alert will return the current state of the alert with the given ID
+func (m *AlertManager) Alert(id AlertID) (Alert, bool) {
+	a, ok := m.alerts[id]
+	if !ok {
+		return Alert{}, false
+	}
+	return *a, true
+}
+
+// Alerts will return all tracked alerts with any of the given states, or all alerts if
+// no states are given. Alerts are ordered by ID.
+func (m *AlertManager) Alerts(states ...AlertState) []Alert {
+	var res []Alert
+	for _, a := range m.alerts {
+		if len(states) > 0 && !hasAlertState(states, a.State) {
+			continue
+		}
+		res = append(res, *a)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i].ID, res[j].ID
+		if a.Manufacturer != b.Manufacturer {
+			return a.Manufacturer < b.Manufacturer
+		}
+		if a.Identifier != b.Identifier {
+			return a.Identifier < b.Identifier
+		}
+		return a.Instance < b.Instance
+	})
+	return res
+}
+
+func hasAlertState(states []AlertState, s AlertState) bool {
+	for _, v := range states {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Acknowledge will mark an active alert as acknowledged and return the sentence to send to the alert
+// source: ACK for alerts raised by ALR, or ACN for alerts raised by ALF. The sentence is sent with talker.
+func (m *AlertManager) Acknowledge(id AlertID, talker string) (Sentence, error) {
+	a := m.alerts[id]
+	if a == nil {
+		return nil, fmt.Errorf("unknown alert: %+v", id)
+	}
+	if a.State != AlertStateActive {
+		return nil, fmt.Errorf("alert is %s: %+v", a.State, id)
+	}
+	a.State = AlertStateAcknowledged
+
+	if a.bam {
+		return &ACN{
+			Talker:       talker,
+			Time:         time.Now().UTC(),
+			Manufacturer: id.Manufacturer,
+			ID:           id.Identifier,
+			Instance:     id.Instance,
+			Command:      ACNCommandAcknowledge,
+		}, nil
+	}
+	return &ACK{Talker: talker, ID: id.Identifier}, nil
+}
@@ -0,0 +1,96 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertManager(t *testing.T) {
+	m := AlertManager{KeepCleared: 10}
+
+	m.Update(&ALR{Talker: "II", ID: 31, Active: true, Text: "BILGE"})
+	m.Update(&ALF{Talker: "II", Total: 2, Number: 1, Sequence: 3, Priority: ALFPriorityAlarm, State: ALFStateActiveUnacknowledged, Manufacturer: "XYZ", ID: 3001, Instance: 1, Text: "ENGINE "})
+	m.Update(&ALF{Talker: "II", Total: 2, Number: 2, Sequence: 3, Text: "OVERHEAT"})
+	m.Update(&ALR{Talker: "II", ID: 40, Text: "FIRE"})
+
+	active := m.Alerts(AlertStateActive)
+	if assert.Len(t, active, 2) {
+		assert.Equal(t, AlertID{Identifier: 31}, active[0].ID)
+		assert.Equal(t, AlertID{Manufacturer: "XYZ", Identifier: 3001, Instance: 1}, active[1].ID)
+		assert.Equal(t, "ENGINE OVERHEAT", active[1].Text)
+		assert.Equal(t, ALFPriorityAlarm, active[1].Priority)
+	}
+	assert.Len(t, m.Alerts(AlertStateCleared), 1)
+	assert.Len(t, m.Alerts(), 3)
+
+	s, err := m.Acknowledge(AlertID{Identifier: 31}, "VD")
+	assert.Nil(t, err)
+	assert.Equal(t, "$VDACK,031*45", s.String())
+
+	s, err = m.Acknowledge(AlertID{Manufacturer: "XYZ", Identifier: 3001, Instance: 1}, "VD")
+	assert.Nil(t, err)
+	if assert.IsType(t, &ACN{}, s) {
+		assert.Equal(t, ACNCommandAcknowledge, s.(*ACN).Command)
+		assert.Equal(t, 3001, s.(*ACN).ID)
+	}
+	assert.Len(t, m.Alerts(AlertStateAcknowledged), 2)
+
+	_, err = m.Acknowledge(AlertID{Identifier: 31}, "VD")
+	assert.NotNil(t, err, "already acknowledged")
+	_, err = m.Acknowledge(AlertID{Identifier: 99}, "VD")
+	assert.NotNil(t, err, "unknown alert")
+
+	m.Update(&ALR{Talker: "II", ID: 31, Active: false})
+	a, ok := m.Alert(AlertID{Identifier: 31})
+	assert.True(t, ok)
+	assert.Equal(t, AlertStateCleared, a.State)
+
+	// acknowledgments from other stations are tracked
+	m.Update(&ALR{Talker: "II", ID: 50, Active: true})
+	m.Update(&ACK{Talker: "VD", ID: 50})
+	a, _ = m.Alert(AlertID{Identifier: 50})
+	assert.Equal(t, AlertStateAcknowledged, a.State)
+
+	// continuations are only applied to the sentence they follow
+	m.Update(&ALF{Talker: "II", Total: 2, Number: 2, Sequence: 3, Text: " AGAIN"})
+	a, _ = m.Alert(AlertID{Manufacturer: "XYZ", Identifier: 3001, Instance: 1})
+	assert.Equal(t, "ENGINE OVERHEAT", a.Text)
+	m.Update(&ALF{Talker: "II", Total: 2, Number: 2, Sequence: 7, Text: "LOST"})
+	_, ok = m.Alert(AlertID{})
+	assert.False(t, ok)
+	assert.Len(t, m.Alerts(), 4)
+}
+
+func TestAlertManager_KeepCleared(t *testing.T) {
+	var m AlertManager
+	m.Update(&ALR{Talker: "II", ID: 31, Active: true, Text: "BILGE"})
+	m.Update(&ALF{Talker: "II", Total: 1, Number: 1, Priority: ALFPriorityWarning, State: ALFStateActiveUnacknowledged, Manufacturer: "XYZ", ID: 3001, Instance: 1, Text: "ENGINE"})
+	assert.Len(t, m.Alerts(), 2)
+
+	// cleared alerts are removed by default
+	m.Update(&ALR{Talker: "II", ID: 31, Active: false, Text: "BILGE"})
+	m.Update(&ALF{Talker: "II", Total: 1, Number: 1, Priority: ALFPriorityWarning, State: ALFStateNormal, Manufacturer: "XYZ", ID: 3001, Instance: 1, Text: "ENGINE"})
+	assert.Empty(t, m.Alerts())
+
+	// only the most recently cleared alerts are kept
+	m.KeepCleared = 1
+	for id := 1; id <= 3; id++ {
+		m.Update(&ALR{Talker: "II", ID: id, Active: true})
+	}
+	m.Update(&ALR{Talker: "II", ID: 1, Active: false})
+	m.Update(&ALR{Talker: "II", ID: 2, Active: false})
+	cleared := m.Alerts(AlertStateCleared)
+	if assert.Len(t, cleared, 1) {
+		assert.Equal(t, 2, cleared[0].ID.Identifier)
+	}
+
+	// an alert raised again is no longer counted as cleared
+	m.Update(&ALR{Talker: "II", ID: 2, Active: true})
+	m.Update(&ALR{Talker: "II", ID: 3, Active: false})
+	cleared = m.Alerts(AlertStateCleared)
+	if assert.Len(t, cleared, 1) {
+		assert.Equal(t, 3, cleared[0].ID.Identifier)
+	}
+	assert.Len(t, m.Alerts(AlertStateActive), 1)
+}
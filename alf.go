@@ -0,0 +1,170 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ALFCategory is the category of an alert reported by ALF
+type ALFCategory string
+
+// Alert categories for ALF
+const (
+	ALFCategoryA ALFCategory = "A" // alerts where graphical information at the task station is required
+	ALFCategoryB ALFCategory = "B" // alerts where no additional information is required
+	ALFCategoryC ALFCategory = "C" // alerts that cannot be acknowledged on the bridge
+)
+
+// ALFPriority is the priority of an alert reported by ALF
+type ALFPriority string
+
+// Alert priorities for ALF
+const (
+	ALFPriorityEmergency ALFPriority = "E" // emergency alarm
+	ALFPriorityAlarm     ALFPriority = "A"
+	ALFPriorityWarning   ALFPriority = "W"
+	ALFPriorityCaution   ALFPriority = "C"
+)
+
+// ALFState is the state of an alert reported by ALF
+type ALFState string
+
+// Alert states for ALF
+const (
+	ALFStateActiveUnacknowledged ALFState = "V"
+	ALFStateActiveSilenced       ALFState = "S"
+	ALFStateActiveAcknowledged   ALFState = "A"
+	ALFStateActiveTransferred    ALFState = "O" // responsibility transferred
+	ALFStateRectified            ALFState = "U" // rectified, but not yet acknowledged
+	ALFStateNormal               ALFState = "N"
+)
+
+// ALF reports an alert as part of bridge alert management. Long text is continued in a second sentence
+// with the same Sequence, in which only Total, Number, Sequence and Text are set.
+type ALF struct {
+	Talker       string      `csv:"talker"`       // talker ID, defaults to II (integrated instrumentation) when serializing
+	Total        int         `csv:"total"`        // total number of sentences for this alert, 1 or 2
+	Number       int         `csv:"number"`       // number of this sentence
+	Sequence     int         `csv:"sequence"`     // sequential message identifier, 0 to 9
+	Time         time.Time   `csv:"time"`         // time of the last change in state
+	Category     ALFCategory `csv:"category"`     // alert category
+	Priority     ALFPriority `csv:"priority"`     // alert priority
+	State        ALFState    `csv:"state"`        // alert state
+	Manufacturer string      `csv:"manufacturer"` // manufacturer mnemonic code, empty for standardized alerts
+	ID           int         `csv:"id"`           // alert identifier
+	Instance     int         `csv:"instance"`     // alert instance
+	Revision     int         `csv:"revision"`     // revision counter, incremented on each change
+	Escalation   int         `csv:"escalation"`   // escalation counter
+	Text         string      `csv:"text"`         // alert text
+}
+
+// Type returns TypeALF to fulfill the Sentence interface
+func (a ALF) Type() Type {
+	return TypeALF
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (a ALF) String() string {
+	fields := []string{
+		strconv.Itoa(a.Total),
+		strconv.Itoa(a.Number),
+		strconv.Itoa(a.Sequence),
+		"", "", "", "", "", "", "", "", "",
+		a.Text,
+	}
+	if a.Number <= 1 {
+		fields[3] = a.Time.Format(timeFormat)
+		fields[4] = string(a.Category)
+		fields[5] = string(a.Priority)
+		fields[6] = string(a.State)
+		fields[7] = a.Manufacturer
+		fields[8] = strconv.Itoa(a.ID)
+		fields[9] = strconv.Itoa(a.Instance)
+		fields[10] = strconv.Itoa(a.Revision)
+		fields[11] = strconv.Itoa(a.Escalation)
+	}
+	return Raw{
+		TypeName: typeName(a.Talker, "II", TypeALF),
+		Fields:   fields,
+	}.String()
+}
+
+// Parse will parse ALF data from a raw sentence struct
+func (a *ALF) Parse(r *Raw) error {
+	var err error
+	a.Talker, err = parseTalker(r, TypeALF)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 13 {
+		return fmt.Errorf("not enough fields, need at least 13")
+	}
+
+	a.Total, err = parseFieldInt(r.Fields[0], "Total")
+	if err != nil {
+		return err
+	}
+	a.Number, err = parseFieldInt(r.Fields[1], "Number")
+	if err != nil {
+		return err
+	}
+	a.Sequence, err = parseFieldInt(r.Fields[2], "Sequence")
+	if err != nil {
+		return err
+	}
+
+	if r.Fields[3] != "" {
+		a.Time, err = time.ParseInLocation(timeFormat, r.Fields[3], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	} else {
+		a.Time = time.Time{}
+	}
+
+	switch ALFCategory(r.Fields[4]) {
+	case ALFCategoryA, ALFCategoryB, ALFCategoryC, ALFCategory(""):
+		a.Category = ALFCategory(r.Fields[4])
+	default:
+		return fmt.Errorf("invalid alert category: %s", r.Fields[4])
+	}
+
+	switch ALFPriority(r.Fields[5]) {
+	case ALFPriorityEmergency, ALFPriorityAlarm, ALFPriorityWarning, ALFPriorityCaution, ALFPriority(""):
+		a.Priority = ALFPriority(r.Fields[5])
+	default:
+		return fmt.Errorf("invalid alert priority: %s", r.Fields[5])
+	}
+
+	switch ALFState(r.Fields[6]) {
+	case ALFStateActiveUnacknowledged, ALFStateActiveSilenced, ALFStateActiveAcknowledged,
+		ALFStateActiveTransferred, ALFStateRectified, ALFStateNormal, ALFState(""):
+
+		a.State = ALFState(r.Fields[6])
+	default:
+		return fmt.Errorf("invalid alert state: %s", r.Fields[6])
+	}
+
+	a.Manufacturer = r.Fields[7]
+
+	a.ID, err = parseFieldInt(r.Fields[8], "ID")
+	if err != nil {
+		return err
+	}
+	a.Instance, err = parseFieldInt(r.Fields[9], "Instance")
+	if err != nil {
+		return err
+	}
+	a.Revision, err = parseFieldInt(r.Fields[10], "Revision")
+	if err != nil {
+		return err
+	}
+	a.Escalation, err = parseFieldInt(r.Fields[11], "Escalation")
+	if err != nil {
+		return err
+	}
+
+	a.Text = r.Fields[12]
+	return nil
+}
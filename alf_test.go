@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const alfStr = "$IIALF,1,1,0,124304.50,A,W,A,,192,1,1,0,LOST TARGET*14"
+
+func TestALF_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(alfStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := new(ALF)
+	err = a.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeALF, a.Type(), "type")
+	assert.Equal(t, 1, a.Total, "total")
+	assert.Equal(t, 1, a.Number, "number")
+	assert.Equal(t, 0, a.Sequence, "sequence")
+	assert.Equal(t, "12:43:04.5", a.Time.Format("15:04:05.9"), "timestamp")
+	assert.Equal(t, ALFCategoryA, a.Category, "category")
+	assert.Equal(t, ALFPriorityWarning, a.Priority, "priority")
+	assert.Equal(t, ALFStateActiveAcknowledged, a.State, "state")
+	assert.Equal(t, "", a.Manufacturer, "manufacturer")
+	assert.Equal(t, 192, a.ID, "ID")
+	assert.Equal(t, 1, a.Instance, "instance")
+	assert.Equal(t, 1, a.Revision, "revision")
+	assert.Equal(t, 0, a.Escalation, "escalation")
+	assert.Equal(t, "LOST TARGET", a.Text, "text")
+}
+
+func TestALF_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := ALF{
+		Total:        1,
+		Number:       1,
+		Sequence:     4,
+		Time:         tm,
+		Category:     ALFCategoryB,
+		Priority:     ALFPriorityAlarm,
+		State:        ALFStateActiveUnacknowledged,
+		Manufacturer: "XYZ",
+		ID:           3001,
+		Instance:     2,
+		Revision:     5,
+		Text:         "ENGINE",
+	}.String()
+	assert.Equal(t, "$IIALF,1,1,4,040506,B,A,V,XYZ,3001,2,5,0,ENGINE*61", str)
+
+	str = ALF{Total: 2, Number: 2, Sequence: 3, Text: "CONTINUED"}.String()
+	assert.Equal(t, "$IIALF,2,2,3,,,,,,,,,,CONTINUED*11", str)
+}
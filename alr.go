@@ -0,0 +1,74 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ALR reports the state of an alarm
+type ALR struct {
+	Talker       string    `csv:"talker"`       // talker ID, defaults to II (integrated instrumentation) when serializing
+	Time         time.Time `csv:"time"`         // time of the last change in state
+	ID           int       `csv:"id"`           // alarm identifier, 000 to 999
+	Active       bool      `csv:"active"`       // true if the alarm condition is present (threshold exceeded)
+	Acknowledged bool      `csv:"acknowledged"` // true if the alarm has been acknowledged
+	Text         string    `csv:"text"`         // alarm description
+}
+
+// Type returns TypeALR to fulfill the Sentence interface
+func (a ALR) Type() Type {
+	return TypeALR
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (a ALR) String() string {
+	return Raw{
+		TypeName: typeName(a.Talker, "II", TypeALR),
+		Fields: []string{
+			a.Time.Format(timeFormat),
+			fmt.Sprintf("%03d", a.ID),
+			formatFieldStatus(a.Active),
+			formatFieldStatus(a.Acknowledged),
+			a.Text,
+		},
+	}.String()
+}
+
+// Parse will parse ALR data from a raw sentence struct
+func (a *ALR) Parse(r *Raw) error {
+	var err error
+	a.Talker, err = parseTalker(r, TypeALR)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	if r.Fields[0] != "" {
+		a.Time, err = time.ParseInLocation(timeFormat, r.Fields[0], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	} else {
+		a.Time = time.Time{}
+	}
+
+	a.ID, err = strconv.Atoi(r.Fields[1])
+	if err != nil {
+		return fmt.Errorf("parse ID: %s", err)
+	}
+
+	a.Active, err = parseFieldStatus(r.Fields[2], "condition")
+	if err != nil {
+		return err
+	}
+	a.Acknowledged, err = parseFieldStatus(r.Fields[3], "acknowledge state")
+	if err != nil {
+		return err
+	}
+
+	a.Text = r.Fields[4]
+	return nil
+}
@@ -0,0 +1,38 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const alrStr = "$IIALR,225444,031,A,V,BILGE HIGH WATER*49"
+
+func TestALR_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(alrStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := new(ALR)
+	err = a.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeALR, a.Type(), "type")
+	assert.Equal(t, "225444", a.Time.Format(timeFormat), "timestamp")
+	assert.Equal(t, 31, a.ID, "ID")
+	assert.True(t, a.Active, "active")
+	assert.False(t, a.Acknowledged, "acknowledged")
+	assert.Equal(t, "BILGE HIGH WATER", a.Text, "text")
+}
+
+func TestALR_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := ALR{Time: tm, ID: 5, Acknowledged: true, Text: "FIRE"}.String()
+
+	assert.Equal(t, "$IIALR,040506,005,V,A,FIRE*4E", str)
+}
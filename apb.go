@@ -0,0 +1,116 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+// APB reports autopilot steering data towards a destination waypoint
+type APB struct {
+	Talker         string   `csv:"talker"`           // talker ID, defaults to GP when serializing
+	Valid          bool     `csv:"valid"`            // true if the data is reported as valid
+	CrossTrack     float64  `csv:"cross_track_nm"`   // cross-track error in nautical miles. Positive to steer right, negative to steer left
+	ArrivalCircle  bool     `csv:"arrival_circle"`   // true if the arrival circle has been entered
+	Perpendicular  bool     `csv:"perpendicular"`    // true if the perpendicular at the destination waypoint has been passed
+	BearingOrigin  Bearing  `csv:"bearing_origin"`   // bearing from origin to destination
+	Destination    string   `csv:"destination"`      // destination waypoint ID
+	BearingPresent Bearing  `csv:"bearing_present"`  // bearing from present position to destination
+	HeadingToSteer Bearing  `csv:"heading_to_steer"` // heading to steer to the destination
+	FixType        GPRMCFix `csv:"fix_type"`
+}
+
+// Type returns TypeAPB to fulfill the Sentence interface
+func (a APB) Type() Type {
+	return TypeAPB
+}
+
+// String will provide a NMEA formatted string. Cross-track error is written in nautical miles
+func (a APB) String() string {
+	xte, steer := formatFieldSteer(a.CrossTrack)
+	origin, originRef := a.BearingOrigin.fields()
+	present, presentRef := a.BearingPresent.fields()
+	heading, headingRef := a.HeadingToSteer.fields()
+	return Raw{
+		TypeName: typeName(a.Talker, "GP", TypeAPB),
+		Fields: []string{
+			formatFieldStatus(a.Valid),
+			formatFieldStatus(a.Valid),
+			xte,
+			steer,
+			"N",
+			formatFieldStatus(a.ArrivalCircle),
+			formatFieldStatus(a.Perpendicular),
+			origin,
+			originRef,
+			a.Destination,
+			present,
+			presentRef,
+			heading,
+			headingRef,
+			string(a.FixType),
+		},
+	}.String()
+}
+
+// Parse will parse APB data from a raw sentence struct. The data is only considered valid if both status fields are valid
+func (a *APB) Parse(r *Raw) error {
+	var err error
+	a.Talker, err = parseTalker(r, TypeAPB)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 14 {
+		return fmt.Errorf("not enough fields, need at least 14")
+	}
+
+	warn, err := parseFieldStatus(r.Fields[0], "status")
+	if err != nil {
+		return err
+	}
+	lock, err := parseFieldStatus(r.Fields[1], "cycle lock status")
+	if err != nil {
+		return err
+	}
+	a.Valid = warn && lock
+
+	a.CrossTrack, err = parseFieldSteer(r.Fields[2], r.Fields[3], "CrossTrack")
+	if err != nil {
+		return err
+	}
+	switch r.Fields[4] {
+	case "N", "":
+	case "K":
+		a.CrossTrack = a.CrossTrack * 1000 / 1852
+	default:
+		return fmt.Errorf("unknown unit for CrossTrack: %s", r.Fields[4])
+	}
+
+	a.ArrivalCircle, err = parseFieldStatus(r.Fields[5], "arrival circle status")
+	if err != nil {
+		return err
+	}
+	a.Perpendicular, err = parseFieldStatus(r.Fields[6], "perpendicular status")
+	if err != nil {
+		return err
+	}
+
+	a.BearingOrigin, err = parseFieldBearing(r.Fields[7], r.Fields[8], "BearingOrigin")
+	if err != nil {
+		return err
+	}
+	a.Destination = r.Fields[9]
+	a.BearingPresent, err = parseFieldBearing(r.Fields[10], r.Fields[11], "BearingPresent")
+	if err != nil {
+		return err
+	}
+	a.HeadingToSteer, err = parseFieldBearing(r.Fields[12], r.Fields[13], "HeadingToSteer")
+	if err != nil {
+		return err
+	}
+
+	a.FixType, err = parseFieldFixType(r.Fields, 14)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
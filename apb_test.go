@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const apbStr = "$GPAPB,A,A,0.10,R,N,V,V,011,M,DEST,011,M,011,M*3C"
+
+func TestAPB_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(apbStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := new(APB)
+	err = a.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeAPB, a.Type(), "type")
+	assert.True(t, a.Valid, "valid")
+	assert.Equal(t, 0.1, a.CrossTrack, "cross track")
+	assert.False(t, a.ArrivalCircle, "arrival circle")
+	assert.False(t, a.Perpendicular, "perpendicular")
+	assert.Equal(t, Bearing{Degrees: 11, Magnetic: true}, a.BearingOrigin, "bearing origin")
+	assert.Equal(t, "DEST", a.Destination, "destination")
+	assert.Equal(t, Bearing{Degrees: 11, Magnetic: true}, a.BearingPresent, "bearing present")
+	assert.Equal(t, Bearing{Degrees: 11, Magnetic: true}, a.HeadingToSteer, "heading to steer")
+}
+
+func TestAPB_String(t *testing.T) {
+	str := APB{
+		Valid:          true,
+		CrossTrack:     -1.25,
+		ArrivalCircle:  true,
+		BearingOrigin:  Bearing{Degrees: 45},
+		Destination:    "HOME",
+		BearingPresent: Bearing{Degrees: 47.5},
+		HeadingToSteer: Bearing{Degrees: 40, Magnetic: true},
+		FixType:        GPRMCFixDifferential,
+	}.String()
+
+	assert.Equal(t, "$GPAPB,A,A,1.25,L,N,A,V,45,T,HOME,47.5,T,40,M,D*7E", str)
+}
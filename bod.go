@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BOD reports the bearing from an origin waypoint to a destination waypoint
+type BOD struct {
+	Talker          string  `csv:"talker"`               // talker ID, defaults to GP when serializing
+	BearingTrue     float64 `csv:"bearing_true_deg"`     // bearing in degrees True
+	BearingMagnetic float64 `csv:"bearing_magnetic_deg"` // bearing in degrees Magnetic
+	Destination     string  `csv:"destination"`          // destination waypoint ID
+	Origin          string  `csv:"origin"`               // origin waypoint ID
+}
+
+// Type returns TypeBOD to fulfill the Sentence interface
+func (b BOD) Type() Type {
+	return TypeBOD
+}
+
+// String will provide a NMEA formatted string
+func (b BOD) String() string {
+	return Raw{
+		TypeName: typeName(b.Talker, "GP", TypeBOD),
+		Fields: []string{
+			strconv.FormatFloat(b.BearingTrue, 'f', -1, 64),
+			"T",
+			strconv.FormatFloat(b.BearingMagnetic, 'f', -1, 64),
+			"M",
+			b.Destination,
+			b.Origin,
+		},
+	}.String()
+}
+
+// Parse will parse BOD data from a raw sentence struct
+func (b *BOD) Parse(r *Raw) error {
+	var err error
+	b.Talker, err = parseTalker(r, TypeBOD)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 6 {
+		return fmt.Errorf("not enough fields, need at least 6")
+	}
+
+	b.BearingTrue, err = parseFieldFloat(r.Fields[0], "BearingTrue")
+	if err != nil {
+		return err
+	}
+	b.BearingMagnetic, err = parseFieldFloat(r.Fields[2], "BearingMagnetic")
+	if err != nil {
+		return err
+	}
+
+	b.Destination = r.Fields[4]
+	b.Origin = r.Fields[5]
+	return nil
+}
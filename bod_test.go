@@ -0,0 +1,32 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const bodStr = "$GPBOD,099.3,T,105.6,M,POINTB,POINTA*45"
+
+func TestBOD_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(bodStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(BOD)
+	err = b.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeBOD, b.Type(), "type")
+	assert.Equal(t, 99.3, b.BearingTrue, "bearing true")
+	assert.Equal(t, 105.6, b.BearingMagnetic, "bearing magnetic")
+	assert.Equal(t, "POINTB", b.Destination, "destination")
+	assert.Equal(t, "POINTA", b.Origin, "origin")
+}
+
+func TestBOD_String(t *testing.T) {
+	str := BOD{BearingTrue: 10, BearingMagnetic: 12.5, Destination: "B", Origin: "A"}.String()
+
+	assert.Equal(t, "$GPBOD,10,T,12.5,M,B,A*5D", str)
+}
@@ -0,0 +1,118 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// BWC reports the great circle bearing and distance to a waypoint
+type BWC struct {
+	Talker          string    `csv:"talker"`               // talker ID, defaults to GP when serializing
+	Time            time.Time `csv:"time"`                 // time of the observation
+	Latitude        Coord     `csv:"latitude_deg"`         // waypoint latitude
+	Longitude       Coord     `csv:"longitude_deg"`        // waypoint longitude
+	BearingTrue     float64   `csv:"bearing_true_deg"`     // bearing in degrees True
+	BearingMagnetic float64   `csv:"bearing_magnetic_deg"` // bearing in degrees Magnetic
+	Distance        float64   `csv:"distance_nm"`          // distance in nautical miles
+	Waypoint        string    `csv:"waypoint"`             // waypoint ID
+	FixType         GPRMCFix  `csv:"fix_type"`
+}
+
+// Type returns TypeBWC to fulfill the Sentence interface
+func (b BWC) Type() Type {
+	return TypeBWC
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (b BWC) String() string {
+	return Raw{
+		TypeName: typeName(b.Talker, "GP", TypeBWC),
+		Fields:   bearingWaypointFields(BWR(b)),
+	}.String()
+}
+
+// Parse will parse BWC data from a raw sentence struct
+func (b *BWC) Parse(r *Raw) error {
+	var err error
+	b.Talker, err = parseTalker(r, TypeBWC)
+	if err != nil {
+		return err
+	}
+	return parseBearingWaypoint((*BWR)(b), r)
+}
+
+// bearingWaypointFields will return the fields shared by BWC and BWR
+func bearingWaypointFields(b BWR) []string {
+	return []string{
+		b.Time.Format(timeFormat),
+		b.Latitude.String(),
+		b.Latitude.Direction().LatString(),
+		b.Longitude.LonString(),
+		b.Longitude.Direction().LongString(),
+		strconv.FormatFloat(b.BearingTrue, 'f', -1, 64),
+		"T",
+		strconv.FormatFloat(b.BearingMagnetic, 'f', -1, 64),
+		"M",
+		strconv.FormatFloat(b.Distance, 'f', -1, 64),
+		"N",
+		b.Waypoint,
+		string(b.FixType),
+	}
+}
+
+// parseBearingWaypoint will parse the fields shared by BWC and BWR
+func parseBearingWaypoint(b *BWR, r *Raw) error {
+	if r.Fields == nil || len(r.Fields) < 12 {
+		return fmt.Errorf("not enough fields, need at least 12")
+	}
+
+	var err error
+	if r.Fields[0] != "" {
+		b.Time, err = time.ParseInLocation(timeFormat, r.Fields[0], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	} else {
+		b.Time = time.Time{}
+	}
+
+	b.Latitude, err = parseFieldCoord(r.Fields[1], r.Fields[2], "latitude")
+	if err != nil {
+		return err
+	}
+	b.Longitude, err = parseFieldCoord(r.Fields[3], r.Fields[4], "longitude")
+	if err != nil {
+		return err
+	}
+
+	b.BearingTrue, err = parseFieldFloat(r.Fields[5], "BearingTrue")
+	if err != nil {
+		return err
+	}
+	b.BearingMagnetic, err = parseFieldFloat(r.Fields[7], "BearingMagnetic")
+	if err != nil {
+		return err
+	}
+
+	b.Distance, err = parseFieldFloat(r.Fields[9], "Distance")
+	if err != nil {
+		return err
+	}
+	switch r.Fields[10] {
+	case "N", "":
+	case "K":
+		b.Distance = b.Distance * 1000 / 1852
+	default:
+		return fmt.Errorf("unknown unit for Distance: %s", r.Fields[10])
+	}
+
+	b.Waypoint = r.Fields[11]
+
+	b.FixType, err = parseFieldFixType(r.Fields, 12)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
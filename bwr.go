@@ -0,0 +1,39 @@
+package nmea
+
+import "time"
+
+// BWR reports the rhumb line bearing and distance to a waypoint
+type BWR struct {
+	Talker          string    `csv:"talker"`               // talker ID, defaults to GP when serializing
+	Time            time.Time `csv:"time"`                 // time of the observation
+	Latitude        Coord     `csv:"latitude_deg"`         // waypoint latitude
+	Longitude       Coord     `csv:"longitude_deg"`        // waypoint longitude
+	BearingTrue     float64   `csv:"bearing_true_deg"`     // bearing in degrees True
+	BearingMagnetic float64   `csv:"bearing_magnetic_deg"` // bearing in degrees Magnetic
+	Distance        float64   `csv:"distance_nm"`          // distance in nautical miles
+	Waypoint        string    `csv:"waypoint"`             // waypoint ID
+	FixType         GPRMCFix  `csv:"fix_type"`
+}
+
+// Type returns TypeBWR to fulfill the Sentence interface
+func (b BWR) Type() Type {
+	return TypeBWR
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (b BWR) String() string {
+	return Raw{
+		TypeName: typeName(b.Talker, "GP", TypeBWR),
+		Fields:   bearingWaypointFields(b),
+	}.String()
+}
+
+// Parse will parse BWR data from a raw sentence struct
+func (b *BWR) Parse(r *Raw) error {
+	var err error
+	b.Talker, err = parseTalker(r, TypeBWR)
+	if err != nil {
+		return err
+	}
+	return parseBearingWaypoint(b, r)
+}
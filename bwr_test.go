@@ -0,0 +1,49 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const bwrStr = "$GPBWR,225444,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*38"
+
+func TestBWR_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(bwrStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(BWR)
+	err = b.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeBWR, b.Type(), "type")
+	assert.Equal(t, "225444", b.Time.Format(timeFormat), "timestamp")
+	assert.InDelta(t, 49.287333, float64(b.Latitude), epsilon, "latitude")
+	assert.Equal(t, 51.9, b.BearingTrue, "bearing true")
+	assert.Equal(t, 31.6, b.BearingMagnetic, "bearing magnetic")
+	assert.Equal(t, 1.3, b.Distance, "distance")
+	assert.Equal(t, "004", b.Waypoint, "waypoint")
+	assert.Equal(t, GPRMCFixUnspecified, b.FixType)
+}
+
+func TestBWR_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := BWR{
+		Time:            tm,
+		Latitude:        Coord(-45.51),
+		Longitude:       Coord(45.26),
+		BearingTrue:     90,
+		BearingMagnetic: 92.5,
+		Distance:        3.25,
+		Waypoint:        "WPT1",
+		FixType:         GPRMCFixAutonomous,
+	}.String()
+
+	assert.Equal(t, "$GPBWR,040506,4530.6,S,04515.6,E,90,T,92.5,M,3.25,N,WPT1,A*2D", str)
+}
@@ -1,6 +1,7 @@
 package nmea
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -109,7 +110,9 @@ func (c Coord) DDM() (deg, min float64, dir CoordDirection) {
 	return deg, min, dir
 }
 
-// ParseCoord will parse a NMEA formatted coordinate and direction into a Coord
+// ParseCoord will parse a NMEA formatted coordinate and direction into a Coord. Latitudes are
+// formatted as ddmm.mmmm and longitudes as dddmm.mmmm; the degrees are the digits before the two
+// whole digits of the minutes, so either is accepted.
 func ParseCoord(c string, dir CoordDirection) (Coord, error) {
 	if len(c) < 3 {
 		deg, err := strconv.ParseFloat(c, 64)
@@ -118,31 +121,47 @@ func ParseCoord(c string, dir CoordDirection) (Coord, error) {
 		}
 		return CoordFromDD(deg, dir), nil
 	}
-	deg, err := strconv.ParseFloat(c[:2], 64)
+	i := strings.IndexByte(c, '.')
+	if i == -1 {
+		i = len(c)
+	}
+	if i < 3 {
+		return 0, fmt.Errorf("invalid coordinate: %s", c)
+	}
+	deg, err := strconv.ParseFloat(c[:i-2], 64)
 	if err != nil {
 		return 0, err
 	}
-	min, err := strconv.ParseFloat(c[2:], 64)
+	min, err := strconv.ParseFloat(c[i-2:], 64)
 	if err != nil {
 		return 0, err
 	}
 	return CoordFromDDM(deg, min, dir), nil
 }
 
-// StringLat will return the coordinate as a NMEA-formatted string
+// String will return the coordinate as a NMEA-formatted latitude (ddmm.mmmm)
 func (c Coord) String() string {
+	return c.formatDDM(2)
+}
+
+// LonString will return the coordinate as a NMEA-formatted longitude (dddmm.mmmm)
+func (c Coord) LonString() string {
+	return c.formatDDM(3)
+}
+
+// formatDDM will format the coordinate as degrees, padded to digits, followed by decimal minutes
+func (c Coord) formatDDM(digits int) string {
 	deg, min, _ := c.DDM()
-	degI := int(deg)
-	degStr := strconv.Itoa(degI)
-	if degI < 10 {
-		degStr = "0" + degStr
+	degStr := strconv.Itoa(int(deg))
+	if len(degStr) < digits {
+		degStr = strings.Repeat("0", digits-len(degStr)) + degStr
 	}
 	minStr := strconv.FormatFloat(min, 'f', 9, 64)
 	if strings.IndexByte(minStr, '.') == 1 {
 		minStr = "0" + minStr
 	}
 
-	return strings.TrimRight(degStr+minStr, "0")
+	return strings.TrimSuffix(strings.TrimRight(degStr+minStr, "0"), ".")
 }
 
 // Direction will return the direction of the coordinate
@@ -89,3 +89,19 @@ func TestCoordDirection_LongString(t *testing.T) {
 	assert.Equal(t, "E", CoordDirectionEast.LongString())
 	assert.Equal(t, "W", CoordDirectionWest.LongString())
 }
+func TestCoord_LonString(t *testing.T) {
+	assert.Equal(t, "00000", Coord(0).LonString())
+	assert.Equal(t, "09903.9", Coord(99.065).LonString())
+	assert.Equal(t, "10003.9", Coord(-100.065).LonString())
+	assert.Equal(t, "15103.9", Coord(151.065).LonString())
+	assert.Equal(t, "17959.4", Coord(-179.99).LonString())
+}
+func TestCoord_LonRoundTrip(t *testing.T) {
+	for _, lon := range []float64{0, 99.065, 100.065, 151.065, 179.99} {
+		for _, c := range []Coord{Coord(lon), Coord(-lon)} {
+			p, err := ParseCoord(c.LonString(), c.Direction())
+			assert.Nil(t, err, "lon %v", c)
+			assert.InDelta(t, float64(c), float64(p), epsilon, "lon %v", c)
+		}
+	}
+}
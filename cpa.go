@@ -0,0 +1,116 @@
+package nmea
+
+import (
+	"math"
+	"time"
+)
+
+// Vessel is the position and motion of a ship, as used for collision assessment
+type Vessel struct {
+	Latitude  Coord
+	Longitude Coord
+	Speed     float64 // speed over ground in knots
+	Course    float64 // course over ground in degrees True
+}
+
+// VesselFromGPRMC will return the own-ship Vessel described by a GPRMC sentence
+func VesselFromGPRMC(g GPRMC) Vessel {
+	return Vessel{
+		Latitude:  g.Latitude,
+		Longitude: g.Longitude,
+		Speed:     g.Speed,
+		Course:    g.TrueCourse,
+	}
+}
+
+// Target is another vessel (e.g. one decoded from AIS) identified by ID, such as an MMSI
+type Target struct {
+	ID string
+	Vessel
+}
+
+// CPA is the result of a closest point of approach calculation for a single target
+type CPA struct {
+	ID       string
+	Range    float64       // current distance to the target in nautical miles
+	Distance float64       // distance at the closest point of approach in nautical miles
+	Time     time.Duration // time until the closest point of approach. Negative if it has already passed
+	Danger   bool          // true if the target is within the danger zone
+}
+
+// ComputeCPA will calculate the closest point of approach between own and target.
+// Distance is returned in nautical miles. The calculation uses a flat-earth approximation
+// centered on own, which is accurate for the short ranges collision assessment is concerned with.
+func ComputeCPA(own, target Vessel) (dist float64, tcpa time.Duration) {
+	px, py := relativePosition(own, target)
+
+	// relative velocity in knots
+	vx, vy := velocity(target)
+	ox, oy := velocity(own)
+	vx -= ox
+	vy -= oy
+
+	v2 := vx*vx + vy*vy
+	if v2 == 0 {
+		// no relative motion, range will never change
+		return math.Hypot(px, py), 0
+	}
+
+	hours := -(px*vx + py*vy) / v2
+	dist = math.Hypot(px+vx*hours, py+vy*hours)
+	return dist, time.Duration(hours * float64(time.Hour))
+}
+
+// relativePosition will return the position of target relative to own in nautical miles (x east,
+// y north), taking the shorter way around
+func relativePosition(own, target Vessel) (x, y float64) {
+	lat := float64(own.Latitude) * math.Pi / 180
+	x = float64(normalizeLongitude(float64(target.Longitude-own.Longitude))) * 60 * math.Cos(lat)
+	y = float64(target.Latitude-own.Latitude) * 60
+	return x, y
+}
+
+func velocity(v Vessel) (x, y float64) {
+	rad := v.Course * math.Pi / 180
+	return v.Speed * math.Sin(rad), v.Speed * math.Cos(rad)
+}
+
+// CPAMonitor assesses targets against a danger zone, raising an alarm when a target enters it.
+// A target is in the danger zone if it is within Distance, or will come within Distance in Time.
+type CPAMonitor struct {
+	Distance float64       // CPA distance in nautical miles at or below which a target is dangerous
+	Time     time.Duration // TCPA at or below which a target is dangerous
+
+	// Alarm, if set, is called once each time a target enters the danger zone
+	Alarm func(CPA)
+
+	active map[string]bool
+}
+
+// Update will assess all targets relative to own, returning the CPA for each in order.
+// Alarm is called for any target that was not previously in the danger zone, including targets
+// already inside it when first seen. Targets not present in the update have their alarm state cleared.
+func (m *CPAMonitor) Update(own Vessel, targets []Target) []CPA {
+	active := make(map[string]bool, len(targets))
+	res := make([]CPA, len(targets))
+	for i, t := range targets {
+		dist, tcpa := ComputeCPA(own, t.Vessel)
+		rng := math.Hypot(relativePosition(own, t.Vessel))
+		res[i] = CPA{
+			ID:       t.ID,
+			Range:    rng,
+			Distance: dist,
+			Time:     tcpa,
+			Danger:   rng <= m.Distance || dist <= m.Distance && tcpa >= 0 && tcpa <= m.Time,
+		}
+		if !res[i].Danger {
+			continue
+		}
+		active[t.ID] = true
+		if !m.active[t.ID] && m.Alarm != nil {
+			m.Alarm(res[i])
+		}
+	}
+	m.active = active
+	return res
+}
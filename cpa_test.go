@@ -0,0 +1,90 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeCPA(t *testing.T) {
+	own := Vessel{Latitude: Coord(0), Longitude: Coord(0), Speed: 10, Course: 0}
+
+	// head-on, 2nm ahead closing at 20 knots
+	dist, tcpa := ComputeCPA(own, Vessel{Latitude: Coord(2.0 / 60), Longitude: Coord(0), Speed: 10, Course: 180})
+	assert.InDelta(t, 0, dist, epsilon, "distance")
+	assert.InDelta(t, float64(6*time.Minute), float64(tcpa), float64(time.Second), "time")
+
+	// crossing from starboard, 1nm east of the meeting point
+	dist, tcpa = ComputeCPA(own, Vessel{Latitude: Coord(1.0 / 60), Longitude: Coord(1.0 / 60), Speed: 10, Course: 270})
+	assert.InDelta(t, 0, dist, 0.001, "distance")
+	assert.InDelta(t, float64(6*time.Minute), float64(tcpa), float64(time.Second), "time")
+
+	// same course and speed, range never changes
+	dist, tcpa = ComputeCPA(own, Vessel{Latitude: Coord(0), Longitude: Coord(1.0 / 60), Speed: 10, Course: 0})
+	assert.InDelta(t, 1, dist, epsilon, "distance")
+	assert.Equal(t, time.Duration(0), tcpa, "time")
+
+	// astern and opening
+	_, tcpa = ComputeCPA(own, Vessel{Latitude: Coord(-1.0 / 60), Longitude: Coord(0), Speed: 5, Course: 180})
+	assert.True(t, tcpa < 0, "time")
+
+	// either side of the antimeridian, 2nm apart and closing
+	own = Vessel{Latitude: Coord(0), Longitude: Coord(179 + 59.0/60), Speed: 10, Course: 90}
+	dist, tcpa = ComputeCPA(own, Vessel{Latitude: Coord(0), Longitude: Coord(-179 - 59.0/60), Speed: 10, Course: 270})
+	assert.InDelta(t, 0, dist, epsilon, "distance")
+	assert.InDelta(t, float64(6*time.Minute), float64(tcpa), float64(time.Second), "time")
+}
+
+func TestCPAMonitor_Update(t *testing.T) {
+	var alarms []CPA
+	m := &CPAMonitor{
+		Distance: 0.5,
+		Time:     10 * time.Minute,
+		Alarm:    func(c CPA) { alarms = append(alarms, c) },
+	}
+	own := Vessel{Speed: 10, Course: 0}
+	targets := []Target{
+		{ID: "123456789", Vessel: Vessel{Latitude: Coord(2.0 / 60), Speed: 10, Course: 180}},
+		{ID: "987654321", Vessel: Vessel{Latitude: Coord(-1.0 / 60), Speed: 5, Course: 180}},
+	}
+
+	res := m.Update(own, targets)
+	assert.Len(t, res, 2)
+	assert.True(t, res[0].Danger)
+	assert.False(t, res[1].Danger)
+	assert.Len(t, alarms, 1)
+	assert.Equal(t, "123456789", alarms[0].ID)
+
+	// still dangerous, no new alarm
+	m.Update(own, targets)
+	assert.Len(t, alarms, 1)
+
+	// target leaves and returns
+	m.Update(own, targets[1:])
+	m.Update(own, targets)
+	assert.Len(t, alarms, 2)
+}
+
+func TestCPAMonitor_Update_inside(t *testing.T) {
+	var alarms []CPA
+	m := &CPAMonitor{
+		Distance: 0.5,
+		Time:     10 * time.Minute,
+		Alarm:    func(c CPA) { alarms = append(alarms, c) },
+	}
+	own := Vessel{Speed: 10, Course: 0}
+
+	// already inside the zone and opening, the CPA has passed
+	targets := []Target{{ID: "123456789", Vessel: Vessel{Latitude: Coord(-0.2 / 60), Speed: 5, Course: 180}}}
+	res := m.Update(own, targets)
+	assert.InDelta(t, 0.2, res[0].Range, epsilon, "range")
+	assert.True(t, res[0].Time < 0, "time")
+	assert.True(t, res[0].Danger)
+	if assert.Len(t, alarms, 1) {
+		assert.Equal(t, "123456789", alarms[0].ID)
+	}
+
+	m.Update(own, targets)
+	assert.Len(t, alarms, 1)
+}
@@ -0,0 +1,410 @@
+package nmea
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// CSVMarshaler is implemented by sentences that compute their CSV columns, such as to convert values
+// to a common unit. Column names are snake_case and suffixed with their unit where they have one
+// (e.g. speed_kn, altitude_m).
+//
+// Other sentences are flattened field by field, with each column named by the csv tag of the field,
+// following the same convention. The columns of nested structs are prefixed with the tag of the
+// struct field, and lists of values (e.g. RTE waypoints) are written in a single column separated by
+// spaces. Lists of structs are skipped; sentences with one (e.g. GSV, XDR) implement CSVMarshaler.
+// Missing (NaN) values are empty. Fields without a tag are named after the field, with a unit suffix
+// only for coordinates (_deg) and durations (_s).
+type CSVMarshaler interface {
+	CSVHeader() []string
+	CSVRecord() []string
+}
+
+func csvFloat(f float64) string {
+	if math.IsNaN(f) {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// csvTime will format t in RFC 3339, or only the time of day if t has no date
+func csvTime(t time.Time) string {
+	switch {
+	case t.IsZero():
+		return ""
+	case t.Year() == 0:
+		return t.Format("15:04:05.999999999")
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// CSVHeader returns the columns for GPRMC:
+//
+//	time, active, latitude_deg, longitude_deg, speed_kn, course_true_deg, variation_deg, fix_type
+func (g GPRMC) CSVHeader() []string {
+	return []string{"time", "active", "latitude_deg", "longitude_deg", "speed_kn", "course_true_deg", "variation_deg", "fix_type"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (g GPRMC) CSVRecord() []string {
+	return []string{
+		csvTime(g.Time),
+		strconv.FormatBool(g.Active),
+		csvFloat(float64(g.Latitude)),
+		csvFloat(float64(g.Longitude)),
+		csvFloat(g.Speed),
+		csvFloat(g.TrueCourse),
+		csvFloat(float64(g.Variation)),
+		string(g.FixType),
+	}
+}
+
+// CSVHeader returns the columns for GPGGA. The time has no date.
+//
+//	time, latitude_deg, longitude_deg, fix_type, satellites, hdop, altitude_m, geoid_height_m, dgps_age_s, dgps_id
+func (g GPGGA) CSVHeader() []string {
+	return []string{"time", "latitude_deg", "longitude_deg", "fix_type", "satellites", "hdop", "altitude_m", "geoid_height_m", "dgps_age_s", "dgps_id"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (g GPGGA) CSVRecord() []string {
+	return []string{
+		csvTime(g.Time),
+		csvFloat(float64(g.Latitude)),
+		csvFloat(float64(g.Longitude)),
+		string(g.FixType),
+		strconv.Itoa(g.Satellites),
+		csvFloat(g.HDOP),
+		csvFloat(g.Altitude),
+		csvFloat(g.GeoIDHeight),
+		csvFloat(g.DGPSUpdate.Seconds()),
+		g.DGPSID,
+	}
+}
+
+// CSVHeader returns the columns for GPGSA. Satellites are separated by spaces.
+//
+//	auto_selection, fix_type, satellites, pdop, hdop, vdop
+func (g GPGSA) CSVHeader() []string {
+	return []string{"auto_selection", "fix_type", "satellites", "pdop", "hdop", "vdop"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (g GPGSA) CSVRecord() []string {
+	return []string{
+		strconv.FormatBool(g.AutoSelection),
+		string(g.FixType),
+		strings.Join(g.Satellites, " "),
+		csvFloat(g.PDOP),
+		csvFloat(g.HDOP),
+		csvFloat(g.VDOP),
+	}
+}
+
+// CSVHeader returns the columns for VTG:
+//
+//	talker, course_true_deg, course_magnetic_deg, speed_kn, fix_type
+func (v VTG) CSVHeader() []string {
+	return []string{"talker", "course_true_deg", "course_magnetic_deg", "speed_kn", "fix_type"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (v VTG) CSVRecord() []string {
+	return []string{v.Talker, csvFloat(v.TrueCourse), csvFloat(v.MagneticCourse), csvFloat(v.Speed), string(v.FixType)}
+}
+
+// CSVHeader returns the columns for MWV. The speed is converted to knots.
+//
+//	talker, angle_deg, reference, speed_kn, valid
+func (m MWV) CSVHeader() []string {
+	return []string{"talker", "angle_deg", "reference", "speed_kn", "valid"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (m MWV) CSVRecord() []string {
+	speed := math.NaN()
+	if m.Unit != "" {
+		speed = m.Knots()
+	}
+	return []string{m.Talker, csvFloat(m.Angle), string(m.Reference), csvFloat(speed), strconv.FormatBool(m.Valid)}
+}
+
+// CSVHeader returns the columns for GSV, with 4 columns for each of the up to 4 satellites of a
+// sentence. The columns of missing satellites are empty.
+//
+//	talker, total, number, in_view, sat1_prn, sat1_elevation_deg, sat1_azimuth_deg, sat1_snr_dbhz, ..., sat4_snr_dbhz, signal_id
+func (g GSV) CSVHeader() []string {
+	header := []string{"talker", "total", "number", "in_view"}
+	for i := 1; i <= 4; i++ {
+		n := "sat" + strconv.Itoa(i) + "_"
+		header = append(header, n+"prn", n+"elevation_deg", n+"azimuth_deg", n+"snr_dbhz")
+	}
+	return append(header, "signal_id")
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (g GSV) CSVRecord() []string {
+	rec := []string{g.Talker, strconv.Itoa(g.Total), strconv.Itoa(g.Number), strconv.Itoa(g.InView)}
+	for i := 0; i < 4; i++ {
+		if i >= len(g.Satellites) {
+			rec = append(rec, "", "", "", "")
+			continue
+		}
+		sat := g.Satellites[i]
+		rec = append(rec, sat.PRN, csvFloat(sat.Elevation), csvFloat(sat.Azimuth), csvFloat(sat.SNR))
+	}
+	return append(rec, g.SignalID)
+}
+
+// xdrCSVMeasurements is the number of measurement columns written for XDR, the most that fit in a
+// sentence of MaxSentenceLength (",G,,," each, after "$YXXDR" and the checksum)
+const xdrCSVMeasurements = (MaxSentenceLength - len("$YXXDR*00\r\n")) / len(",G,,,")
+
+// CSVHeader returns the columns for XDR, with 4 columns for each measurement up to the most that
+// fit in a sentence. The columns of missing measurements are empty.
+//
+//	talker, measurement1_transducer, measurement1_value, measurement1_unit, measurement1_name, ...
+func (x XDR) CSVHeader() []string {
+	header := []string{"talker"}
+	for i := 1; i <= xdrCSVMeasurements; i++ {
+		n := "measurement" + strconv.Itoa(i) + "_"
+		header = append(header, n+"transducer", n+"value", n+"unit", n+"name")
+	}
+	return header
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (x XDR) CSVRecord() []string {
+	rec := []string{x.Talker}
+	for i := 0; i < xdrCSVMeasurements; i++ {
+		if i >= len(x.Measurements) {
+			rec = append(rec, "", "", "", "")
+			continue
+		}
+		m := x.Measurements[i]
+		rec = append(rec, string(m.Transducer), csvFloat(m.Value), m.Unit, m.Name)
+	}
+	return rec
+}
+
+// CSVHeader returns the columns for a Fix:
+//
+//	time, valid, latitude_deg, longitude_deg, altitude_m, speed_kn, course_true_deg, satellites, hdop, vdop, pdop, quality, mode
+func (f Fix) CSVHeader() []string {
+	return []string{"time", "valid", "latitude_deg", "longitude_deg", "altitude_m", "speed_kn", "course_true_deg", "satellites", "hdop", "vdop", "pdop", "quality", "mode"}
+}
+
+// CSVRecord returns the values for the columns of CSVHeader
+func (f Fix) CSVRecord() []string {
+	return []string{
+		csvTime(f.Time),
+		strconv.FormatBool(f.Valid),
+		csvFloat(float64(f.Latitude)),
+		csvFloat(float64(f.Longitude)),
+		csvFloat(f.Altitude),
+		csvFloat(f.Speed),
+		csvFloat(f.Course),
+		strconv.Itoa(f.Satellites),
+		csvFloat(f.HDOP),
+		csvFloat(f.VDOP),
+		csvFloat(f.PDOP),
+		string(f.Quality),
+		string(f.Mode),
+	}
+}
+
+// csvSnakeCase will convert a field name such as GeoIDHeight to geo_id_height
+func csvSnakeCase(name string) string {
+	r := []rune(name)
+	var b strings.Builder
+	for i, c := range r {
+		if i > 0 && unicode.IsUpper(c) && (unicode.IsLower(r[i-1]) || i+1 < len(r) && unicode.IsLower(r[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	coordType    = reflect.TypeOf(Coord(0))
+)
+
+// csvFields will flatten a struct into columns, named by csv tag. Nested structs are prefixed with their tag.
+func csvFields(v reflect.Value, prefix string, header, rec *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("csv")
+		if f.PkgPath != "" || tag == "-" {
+			continue
+		}
+		fv := v.Field(i)
+
+		name := prefix + tag
+		if tag == "" {
+			name = prefix + csvSnakeCase(f.Name)
+			switch f.Type {
+			case durationType:
+				name += "_s"
+			case coordType:
+				name += "_deg"
+			}
+		}
+
+		switch {
+		case f.Type.Kind() == reflect.Struct && f.Type != timeType:
+			if f.Anonymous && tag == "" {
+				name = prefix
+			} else {
+				name += "_"
+			}
+			csvFields(fv, name, header, rec)
+			continue
+		case f.Type.Kind() == reflect.Ptr, f.Type.Kind() == reflect.Map:
+			continue
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Struct:
+			continue
+		}
+		*header = append(*header, name)
+		*rec = append(*rec, csvValue(fv))
+	}
+}
+
+// csvValue will format a value for a single column. Slice elements are separated by spaces.
+func csvValue(v reflect.Value) string {
+	switch {
+	case v.Type() == timeType:
+		return csvTime(v.Interface().(time.Time))
+	case v.Type() == durationType:
+		return csvFloat(time.Duration(v.Int()).Seconds())
+	case v.Kind() == reflect.Float32, v.Kind() == reflect.Float64:
+		return csvFloat(v.Float())
+	case v.Kind() == reflect.Slice:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = csvValue(v.Index(i))
+		}
+		return strings.Join(parts, " ")
+	}
+	return fmtCSVValue(v)
+}
+
+func fmtCSVValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.String:
+		return v.String()
+	}
+	return ""
+}
+
+// CSVColumns will return the column names and values for a sentence, using CSVMarshaler if implemented
+func CSVColumns(s interface{}) (header, record []string) {
+	if m, ok := s.(CSVMarshaler); ok {
+		return m.CSVHeader(), m.CSVRecord()
+	}
+	v := reflect.Indirect(reflect.ValueOf(s))
+	if v.Kind() != reflect.Struct {
+		return nil, nil
+	}
+	csvFields(v, "", &header, &record)
+	return header, record
+}
+
+// CSVTypeWriter writes sentences as CSV with a separate table for each sentence type. Sentences of
+// an unknown type (*Raw) are skipped.
+type CSVTypeWriter struct {
+	open    func(t Type) (io.Writer, error)
+	writers map[Type]*csv.Writer
+}
+
+// NewCSVTypeWriter will return a CSVTypeWriter that calls open to get the destination for each
+// sentence type, the first time that type is written. A header row is written first.
+func NewCSVTypeWriter(open func(t Type) (io.Writer, error)) *CSVTypeWriter {
+	return &CSVTypeWriter{open: open, writers: make(map[Type]*csv.Writer)}
+}
+
+// WriteSentence will write the sentence as a row in the table for its type
+func (w *CSVTypeWriter) WriteSentence(s Sentence) error {
+	if _, ok := s.(*Raw); ok {
+		return nil
+	}
+	header, rec := CSVColumns(s)
+	cw, ok := w.writers[s.Type()]
+	if !ok {
+		dst, err := w.open(s.Type())
+		if err != nil {
+			return err
+		}
+		cw = csv.NewWriter(dst)
+		w.writers[s.Type()] = cw
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	return cw.Write(rec)
+}
+
+// Flush will flush all tables
+func (w *CSVTypeWriter) Flush() error {
+	for _, cw := range w.writers {
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CSVFixWriter writes a table with a row for each epoch, aggregating sentences with a FixAggregator
+type CSVFixWriter struct {
+	w      *csv.Writer
+	agg    FixAggregator
+	header bool
+}
+
+// NewCSVFixWriter will return a CSVFixWriter writing to w
+func NewCSVFixWriter(w io.Writer) *CSVFixWriter {
+	return &CSVFixWriter{w: csv.NewWriter(w)}
+}
+
+func (w *CSVFixWriter) writeFix(f *Fix) error {
+	if f == nil {
+		return nil
+	}
+	if !w.header {
+		w.header = true
+		if err := w.w.Write(f.CSVHeader()); err != nil {
+			return err
+		}
+	}
+	return w.w.Write(f.CSVRecord())
+}
+
+// WriteSentence will add the sentence to the current epoch, writing the row for the previous epoch once it is complete
+func (w *CSVFixWriter) WriteSentence(s Sentence) error {
+	return w.writeFix(w.agg.Update(s))
+}
+
+// Flush will write the row for the current epoch and flush the output
+func (w *CSVFixWriter) Flush() error {
+	if err := w.writeFix(w.agg.Flush()); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,167 @@
+package nmea
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVColumns(t *testing.T) {
+	header, rec := CSVColumns(&GPGGA{
+		Time:        time.Date(0, 1, 1, 23, 22, 0, 0, time.UTC),
+		Latitude:    45.5,
+		Longitude:   -93.25,
+		FixType:     GPGGAFixDGPS,
+		Satellites:  8,
+		HDOP:        1.1,
+		Altitude:    310.5,
+		GeoIDHeight: -31.9,
+		DGPSUpdate:  1500 * time.Millisecond,
+		DGPSID:      "0001",
+	})
+	assert.Equal(t, GPGGA{}.CSVHeader(), header)
+	assert.Equal(t, []string{"23:22:00", "45.5", "-93.25", "2", "8", "1.1", "310.5", "-31.9", "1.5", "0001"}, rec)
+
+	// flattened by field
+	header, rec = CSVColumns(&APB{
+		Talker:         "GP",
+		CrossTrack:     -0.5,
+		BearingOrigin:  Bearing{Degrees: 12, Magnetic: true},
+		HeadingToSteer: Bearing{Degrees: math.NaN()},
+	})
+	assert.Equal(t, []string{
+		"talker", "valid", "cross_track_nm", "arrival_circle", "perpendicular",
+		"bearing_origin_deg", "bearing_origin_magnetic", "destination",
+		"bearing_present_deg", "bearing_present_magnetic",
+		"heading_to_steer_deg", "heading_to_steer_magnetic", "fix_type",
+	}, header)
+	assert.Equal(t, []string{"GP", "false", "-0.5", "false", "false", "12", "true", "", "0", "false", "", "false", ""}, rec)
+
+	header, _ = CSVColumns(&TTM{})
+	assert.Contains(t, header, "tcpa_s")
+	header, _ = CSVColumns(&WPL{})
+	assert.Contains(t, header, "latitude_deg")
+	header, rec = CSVColumns(&DBT{Talker: "SD", Depth: 3.5})
+	assert.Equal(t, []string{"talker", "depth_m"}, header)
+	assert.Equal(t, []string{"SD", "3.5"}, rec)
+	header, _ = CSVColumns(&RSD{})
+	assert.Contains(t, header, "origin1_range_nm")
+	assert.Contains(t, header, "cursor_bearing_deg")
+
+	header, rec = CSVColumns(&MWV{Talker: "WI", Angle: 45, Reference: MWVReferenceRelative, Speed: 10, Unit: SpeedUnitMetersPerSecond, Valid: true})
+	assert.Equal(t, []string{"talker", "angle_deg", "reference", "speed_kn", "valid"}, header)
+	assert.Equal(t, "19.438444924406046", rec[3])
+	_, rec = CSVColumns(&MWV{Talker: "WI"})
+	assert.Equal(t, []string{"WI", "0", "", "", "false"}, rec)
+
+	header, rec = CSVColumns(&RTE{Total: 1, Number: 1, Complete: true, Route: "R1", Waypoints: []string{"A", "B"}})
+	assert.Equal(t, "waypoints", header[len(header)-1])
+	assert.Equal(t, "A B", rec[len(rec)-1])
+
+	// lists of structs in indexed columns, with missing values empty
+	header, rec = CSVColumns(&GSV{Talker: "GP", Total: 1, Number: 1, InView: 2, Satellites: []GSVSatellite{
+		{PRN: "05", Elevation: 40, Azimuth: 83, SNR: 46},
+		{PRN: "17", Elevation: 12, Azimuth: 301, SNR: math.NaN()},
+	}, SignalID: "1"})
+	assert.Len(t, header, 21)
+	assert.Equal(t, []string{"talker", "total", "number", "in_view", "sat1_prn", "sat1_elevation_deg", "sat1_azimuth_deg", "sat1_snr_dbhz", "sat2_prn"}, header[:9])
+	assert.Equal(t, "sat4_snr_dbhz", header[19])
+	assert.Equal(t, "signal_id", header[20])
+	assert.Equal(t, []string{"GP", "1", "1", "2", "05", "40", "83", "46", "17", "12", "301", "", "", "", "", "", "", "", "", "", "1"}, rec)
+
+	header, rec = CSVColumns(&XDR{Talker: "YX", Measurements: []XDRMeasurement{
+		{Transducer: XDRTransducerTemperature, Value: 21.5, Unit: "C", Name: "AIR"},
+		{Transducer: XDRTransducerPressure, Value: 1.013, Unit: "B", Name: "BARO"},
+	}})
+	assert.Len(t, header, 1+4*xdrCSVMeasurements)
+	assert.Equal(t, []string{"talker", "measurement1_transducer", "measurement1_value", "measurement1_unit", "measurement1_name", "measurement2_transducer"}, header[:6])
+	assert.Len(t, rec, len(header))
+	assert.Equal(t, []string{"YX", "C", "21.5", "C", "AIR", "P", "1.013", "B", "BARO", ""}, rec[:10])
+}
+
+// TestCSVColumns_tagged ensures each sentence type has documented columns, from CSVMarshaler or csv tags
+func TestCSVColumns_tagged(t *testing.T) {
+	var check func(typ reflect.Type)
+	check = func(typ reflect.Type) {
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			assert.NotEmpty(t, f.Tag.Get("csv"), "%s.%s", typ.Name(), f.Name)
+			ft := f.Type
+			if ft.Kind() == reflect.Slice {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && ft != timeType {
+				check(ft)
+			}
+		}
+	}
+
+	for _, s := range []Sentence{
+		&ACK{}, &ACN{}, &ALF{}, &ALR{}, &APB{}, &BOD{}, &BWC{}, &BWR{}, &DBK{}, &DBS{}, &DBT{}, &DPT{},
+		&GPGGA{}, &GPGSA{}, &GPRMC{}, &GSV{}, &HDG{}, &HDM{}, &HDT{}, &MTW{}, &MWD{}, &MWV{}, &OSD{},
+		&RMB{}, &ROT{}, &RPM{}, &RSA{}, &RSD{}, &RTE{}, &THS{}, &TLL{}, &TTM{}, &TXT{}, &VHW{}, &VTG{},
+		&VWR{}, &WPL{}, &XDR{}, &XTE{},
+	} {
+		if _, ok := s.(CSVMarshaler); ok {
+			continue
+		}
+		check(reflect.TypeOf(s).Elem())
+	}
+}
+
+func TestCSVSnakeCase(t *testing.T) {
+	assert.Equal(t, "geo_id_height", csvSnakeCase("GeoIDHeight"))
+	assert.Equal(t, "hdop", csvSnakeCase("HDOP"))
+	assert.Equal(t, "dgps_update", csvSnakeCase("DGPSUpdate"))
+	assert.Equal(t, "true_course", csvSnakeCase("TrueCourse"))
+}
+
+func TestCSVTypeWriter(t *testing.T) {
+	files := make(map[Type]*bytes.Buffer)
+	w := NewCSVTypeWriter(func(t Type) (io.Writer, error) {
+		files[t] = new(bytes.Buffer)
+		return files[t], nil
+	})
+
+	sim := &Simulator{Waypoints: []SimWaypoint{{Latitude: 45.5, Longitude: -93.25}}, Start: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)}
+	for i := 0; i < 2; i++ {
+		for _, s := range sim.Next() {
+			assert.Nil(t, w.WriteSentence(s))
+		}
+	}
+	assert.Nil(t, w.WriteSentence(&Raw{TypeName: "PXYZ"}))
+	assert.Nil(t, w.Flush())
+
+	assert.Len(t, files, 5)
+	assert.Equal(t, "time,active,latitude_deg,longitude_deg,speed_kn,course_true_deg,variation_deg,fix_type\n"+
+		"2020-09-13T12:26:40Z,true,45.5,-93.25,0,0,0,A\n"+
+		"2020-09-13T12:26:41Z,true,45.5,-93.25,0,0,0,A\n", files[TypeGPRMC].String())
+	assert.Equal(t, 3, strings.Count(files[TypeGPGSA].String(), "\n"))
+	assert.True(t, strings.HasPrefix(files[TypeGSV].String(), "talker,total,number,in_view,sat1_prn,sat1_elevation_deg,"))
+}
+
+func TestCSVFixWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVFixWriter(&buf)
+
+	sim := &Simulator{Waypoints: []SimWaypoint{{Latitude: 45.5, Longitude: -93.25}}, Start: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC), Altitude: 310.5}
+	for i := 0; i < 2; i++ {
+		for _, s := range sim.Next() {
+			assert.Nil(t, w.WriteSentence(s))
+		}
+	}
+	assert.Nil(t, w.Flush())
+
+	assert.Equal(t, "time,valid,latitude_deg,longitude_deg,altitude_m,speed_kn,course_true_deg,satellites,hdop,vdop,pdop,quality,mode\n"+
+		"2020-09-13T12:26:40Z,true,45.5,-93.25,310.5,0,0,8,0.9,1.2,1.5,1,3\n"+
+		"2020-09-13T12:26:41Z,true,45.5,-93.25,310.5,0,0,8,0.9,1.2,1.5,1,3\n", buf.String())
+}
@@ -0,0 +1,41 @@
+package nmea
+
+import "fmt"
+
+// DBK reports the water depth below the keel
+type DBK struct {
+	Talker string  `csv:"talker"`  // talker ID, defaults to SD (depth sounder) when serializing
+	Depth  float64 `csv:"depth_m"` // depth in meters, NaN if not reported
+}
+
+// Type returns TypeDBK to fulfill the Sentence interface
+func (d DBK) Type() Type {
+	return TypeDBK
+}
+
+// String will provide a NMEA formatted string. Depth is written in feet, meters and fathoms
+func (d DBK) String() string {
+	return Raw{
+		TypeName: typeName(d.Talker, "SD", TypeDBK),
+		Fields:   depthFields(d.Depth),
+	}.String()
+}
+
+// Parse will parse DBK data from a raw sentence struct
+func (d *DBK) Parse(r *Raw) error {
+	var err error
+	d.Talker, err = parseTalker(r, TypeDBK)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 6 {
+		return fmt.Errorf("not enough fields, need at least 6")
+	}
+
+	d.Depth, err = parseFieldDepth(r.Fields[:6], "Depth")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dbkStr = "$SDDBK,25,f,,M,,F*30"
+
+func TestDBK_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(dbkStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DBK)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeDBK, d.Type(), "type")
+	assert.InDelta(t, 7.62, d.Depth, epsilon, "depth")
+}
+
+func TestDBK_String(t *testing.T) {
+	str := DBK{Depth: 1}.String()
+
+	assert.Equal(t, "$SDDBK,3.3,f,1,M,0.5,F*03", str)
+}
@@ -0,0 +1,41 @@
+package nmea
+
+import "fmt"
+
+// DBS reports the water depth below the surface
+type DBS struct {
+	Talker string  `csv:"talker"`  // talker ID, defaults to SD (depth sounder) when serializing
+	Depth  float64 `csv:"depth_m"` // depth in meters, NaN if not reported
+}
+
+// Type returns TypeDBS to fulfill the Sentence interface
+func (d DBS) Type() Type {
+	return TypeDBS
+}
+
+// String will provide a NMEA formatted string. Depth is written in feet, meters and fathoms
+func (d DBS) String() string {
+	return Raw{
+		TypeName: typeName(d.Talker, "SD", TypeDBS),
+		Fields:   depthFields(d.Depth),
+	}.String()
+}
+
+// Parse will parse DBS data from a raw sentence struct
+func (d *DBS) Parse(r *Raw) error {
+	var err error
+	d.Talker, err = parseTalker(r, TypeDBS)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 6 {
+		return fmt.Errorf("not enough fields, need at least 6")
+	}
+
+	d.Depth, err = parseFieldDepth(r.Fields[:6], "Depth")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dbsStr = "$SDDBS,,f,,M,3,F*1C"
+
+func TestDBS_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(dbsStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DBS)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeDBS, d.Type(), "type")
+	assert.InDelta(t, 5.4864, d.Depth, epsilon, "depth")
+}
+
+func TestDBS_String(t *testing.T) {
+	str := DBS{Depth: 3.7}.String()
+
+	assert.Equal(t, "$SDDBS,12.1,f,3.7,M,2.0,F*35", str)
+}
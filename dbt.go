@@ -0,0 +1,90 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// DBT reports the water depth below the transducer
+type DBT struct {
+	Talker string  `csv:"talker"`  // talker ID, defaults to SD (depth sounder) when serializing
+	Depth  float64 `csv:"depth_m"` // depth in meters, NaN if not reported
+}
+
+// Type returns TypeDBT to fulfill the Sentence interface
+func (d DBT) Type() Type {
+	return TypeDBT
+}
+
+// String will provide a NMEA formatted string. Depth is written in feet, meters and fathoms
+func (d DBT) String() string {
+	return Raw{
+		TypeName: typeName(d.Talker, "SD", TypeDBT),
+		Fields:   depthFields(d.Depth),
+	}.String()
+}
+
+// Parse will parse DBT data from a raw sentence struct
+func (d *DBT) Parse(r *Raw) error {
+	var err error
+	d.Talker, err = parseTalker(r, TypeDBT)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 6 {
+		return fmt.Errorf("not enough fields, need at least 6")
+	}
+
+	d.Depth, err = parseFieldDepth(r.Fields[:6], "Depth")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// depthFields will return the feet, meters and fathoms fields used by DBT, DBS and DBK for a depth in meters.
+// The values are left empty if depth is NaN.
+func depthFields(depth float64) []string {
+	if math.IsNaN(depth) {
+		return []string{"", string(DepthUnitFeet), "", string(DepthUnitMeters), "", string(DepthUnitFathoms)}
+	}
+	return []string{
+		strconv.FormatFloat(ConvertDepth(depth, DepthUnitMeters, DepthUnitFeet), 'f', 1, 64),
+		string(DepthUnitFeet),
+		strconv.FormatFloat(depth, 'f', -1, 64),
+		string(DepthUnitMeters),
+		strconv.FormatFloat(ConvertDepth(depth, DepthUnitMeters, DepthUnitFathoms), 'f', 1, 64),
+		string(DepthUnitFathoms),
+	}
+}
+
+// parseFieldDepth will parse pairs of depth and unit fields, returning the depth in meters. The meters
+// value is preferred if present, otherwise the first available value is converted. NaN is returned if
+// no value is present.
+func parseFieldDepth(fields []string, name string) (float64, error) {
+	depth := math.NaN()
+	var found bool
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i] == "" {
+			continue
+		}
+		unit, err := parseDepthUnit(fields[i+1], name)
+		if err != nil {
+			return 0, err
+		}
+		if found && unit != DepthUnitMeters {
+			continue
+		}
+		val, err := parseFieldFloat(fields[i], name)
+		if err != nil {
+			return 0, err
+		}
+		depth, found = ConvertDepth(val, unit, DepthUnitMeters), true
+		if unit == DepthUnitMeters {
+			break
+		}
+	}
+	return depth, nil
+}
@@ -0,0 +1,43 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dbtStr = "$SDDBT,7.8,f,2.4,M,1.3,F*0D"
+
+func TestDBT_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(dbtStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DBT)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeDBT, d.Type(), "type")
+	assert.Equal(t, "SD", d.Talker, "talker")
+	assert.Equal(t, 2.4, d.Depth, "depth")
+}
+
+func TestDBT_String(t *testing.T) {
+	str := DBT{Depth: 10}.String()
+
+	assert.Equal(t, "$SDDBT,32.8,f,10,M,5.5,F*10", str)
+}
+
+func TestDBT_empty(t *testing.T) {
+	r, err := ParseRaw([]byte("$SDDBT,,f,,M,,F*28"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DBT)
+	assert.Nil(t, d.Parse(r))
+	assert.True(t, math.IsNaN(d.Depth), "depth")
+	assert.Equal(t, "$SDDBT,,f,,M,,F*28", d.String())
+}
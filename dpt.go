@@ -0,0 +1,70 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// DPT reports the water depth relative to the transducer along with the transducer offset
+type DPT struct {
+	Talker   string  `csv:"talker"`      // talker ID, defaults to SD (depth sounder) when serializing
+	Depth    float64 `csv:"depth_m"`     // depth relative to the transducer in meters, NaN if not reported
+	Offset   float64 `csv:"offset_m"`    // transducer offset in meters. Positive is the distance to the waterline, negative to the keel
+	MaxRange float64 `csv:"max_range_m"` // maximum range scale in use in meters, added in NMEA version 3.0. NaN if not reported
+}
+
+// Type returns TypeDPT to fulfill the Sentence interface
+func (d DPT) Type() Type {
+	return TypeDPT
+}
+
+// OffsetDepth will return the depth with the transducer offset applied, i.e. below the waterline
+// for a positive Offset or below the keel for a negative one
+func (d DPT) OffsetDepth() float64 {
+	return d.Depth + d.Offset
+}
+
+// String will provide a NMEA formatted string
+func (d DPT) String() string {
+	return Raw{
+		TypeName: typeName(d.Talker, "SD", TypeDPT),
+		Fields: []string{
+			formatFieldOptFloat(d.Depth),
+			formatFieldOptFloat(d.Offset),
+			formatFieldOptFloat(d.MaxRange),
+		},
+	}.String()
+}
+
+// Parse will parse DPT data from a raw sentence struct
+func (d *DPT) Parse(r *Raw) error {
+	var err error
+	d.Talker, err = parseTalker(r, TypeDPT)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	d.Depth, err = parseFieldOptFloat(r.Fields[0], "Depth")
+	if err != nil {
+		return err
+	}
+
+	d.Offset, err = parseFieldOptFloat(r.Fields[1], "Offset")
+	if err != nil {
+		return err
+	}
+
+	if len(r.Fields) >= 3 {
+		d.MaxRange, err = parseFieldOptFloat(r.Fields[2], "MaxRange")
+		if err != nil {
+			return err
+		}
+	} else {
+		d.MaxRange = math.NaN()
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dptStr = "$SDDPT,2.4,-0.5,100*64"
+
+func TestDPT_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(dptStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DPT)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeDPT, d.Type(), "type")
+	assert.Equal(t, 2.4, d.Depth, "depth")
+	assert.Equal(t, -0.5, d.Offset, "offset")
+	assert.Equal(t, 100.0, d.MaxRange, "max range")
+	assert.InDelta(t, 1.9, d.OffsetDepth(), epsilon, "offset depth")
+}
+
+func TestDPT_String(t *testing.T) {
+	str := DPT{Depth: 17.2, Offset: 0.3}.String()
+
+	assert.Equal(t, "$SDDPT,17.2,0.3,0*7C", str)
+}
+
+func TestDPT_Parse_noMaxRange(t *testing.T) {
+	r, err := ParseRaw([]byte("$SDDPT,5.5,1.2*54"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DPT)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+	assert.Equal(t, 5.5, d.Depth, "depth")
+	assert.Equal(t, 1.2, d.Offset, "offset")
+	assert.True(t, math.IsNaN(d.MaxRange), "max range")
+}
+
+func TestDPT_empty(t *testing.T) {
+	r, err := ParseRaw([]byte("$SDDPT,,0.3,*56"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(DPT)
+	assert.Nil(t, d.Parse(r))
+	assert.True(t, math.IsNaN(d.Depth), "depth")
+	assert.Equal(t, 0.3, d.Offset, "offset")
+	assert.True(t, math.IsNaN(d.MaxRange), "max range")
+	assert.Equal(t, "$SDDPT,,0.3,*56", d.String())
+}
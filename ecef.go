@@ -0,0 +1,146 @@
+package nmea
+
+import "math"
+
+// ECEF is a position or velocity in the Earth-centred Earth-fixed frame of the WGS-84 ellipsoid, in
+// meters or meters per second. X points to 0°N 0°E, Y to 0°N 90°E and Z to the north pole.
+type ECEF struct {
+	X, Y, Z float64
+}
+
+// ENU is a position or velocity in a local East, North, Up tangent frame, in meters or meters per second
+type ENU struct {
+	East, North, Up float64
+}
+
+// NED is a position or velocity in a local North, East, Down tangent frame, in meters or meters per second
+type NED struct {
+	North, East, Down float64
+}
+
+// NED will return the vector in the North, East, Down frame
+func (e ENU) NED() NED {
+	return NED{North: e.North, East: e.East, Down: -e.Up}
+}
+
+// ENU will return the vector in the East, North, Up frame
+func (n NED) ENU() ENU {
+	return ENU{East: n.East, North: n.North, Up: -n.Down}
+}
+
+// wgs84E2 is the square of the first eccentricity of the WGS-84 ellipsoid
+const wgs84E2 = WGS84F * (2 - WGS84F)
+
+// ECEFFromCoord will return the ECEF position of lat/lon at height meters above the WGS-84 ellipsoid
+func ECEFFromCoord(lat, lon Coord, height float64) ECEF {
+	sinP, cosP := math.Sincos(toRadians(float64(lat)))
+	sinL, cosL := math.Sincos(toRadians(float64(lon)))
+
+	// prime vertical radius of curvature
+	n := WGS84A / math.Sqrt(1-wgs84E2*sinP*sinP)
+	return ECEF{
+		X: (n + height) * cosP * cosL,
+		Y: (n + height) * cosP * sinL,
+		Z: (n*(1-wgs84E2) + height) * sinP,
+	}
+}
+
+// Coord will return the latitude, longitude and height above the WGS-84 ellipsoid of the position
+func (e ECEF) Coord() (lat, lon Coord, height float64) {
+	p := math.Hypot(e.X, e.Y)
+	lambda := math.Atan2(e.Y, e.X)
+
+	// iterate on latitude, which converges to well below a millimeter within a few rounds
+	// for any position near the surface
+	phi := math.Atan2(e.Z, p*(1-wgs84E2))
+	for i := 0; i < 10; i++ {
+		sinP := math.Sin(phi)
+		n := WGS84A / math.Sqrt(1-wgs84E2*sinP*sinP)
+		prev := phi
+		phi = math.Atan2(e.Z+wgs84E2*n*sinP, p)
+		if math.Abs(phi-prev) < 1e-14 {
+			break
+		}
+	}
+
+	sinP, cosP := math.Sincos(phi)
+	height = p*cosP + e.Z*sinP - WGS84A*math.Sqrt(1-wgs84E2*sinP*sinP)
+	return Coord(toDegrees(phi)), Coord(toDegrees(lambda)), height
+}
+
+// ECEF will return the ECEF position of the fix. The height above the ellipsoid is the altitude
+// above mean sea level plus the geoid height.
+func (g GPGGA) ECEF() ECEF {
+	return ECEFFromCoord(g.Latitude, g.Longitude, g.Altitude+g.GeoIDHeight)
+}
+
+// enuRotate will rotate an ECEF vector into the ENU frame at lat/lon
+func enuRotate(lat, lon Coord, v ECEF) ENU {
+	sinP, cosP := math.Sincos(toRadians(float64(lat)))
+	sinL, cosL := math.Sincos(toRadians(float64(lon)))
+	return ENU{
+		East:  -sinL*v.X + cosL*v.Y,
+		North: -sinP*cosL*v.X - sinP*sinL*v.Y + cosP*v.Z,
+		Up:    cosP*cosL*v.X + cosP*sinL*v.Y + sinP*v.Z,
+	}
+}
+
+// ecefRotate will rotate an ENU vector at lat/lon into the ECEF frame
+func ecefRotate(lat, lon Coord, v ENU) ECEF {
+	sinP, cosP := math.Sincos(toRadians(float64(lat)))
+	sinL, cosL := math.Sincos(toRadians(float64(lon)))
+	return ECEF{
+		X: -sinL*v.East - sinP*cosL*v.North + cosP*cosL*v.Up,
+		Y: cosL*v.East - sinP*sinL*v.North + cosP*sinL*v.Up,
+		Z: cosP*v.North + sinP*v.Up,
+	}
+}
+
+// LocalFrame is a local tangent plane with its origin at a reference position
+type LocalFrame struct {
+	Latitude  Coord
+	Longitude Coord
+	Height    float64 // height of the origin above the WGS-84 ellipsoid in meters
+
+	origin ECEF
+}
+
+// NewLocalFrame will return a local tangent frame with its origin at lat/lon and height meters
+// above the WGS-84 ellipsoid
+func NewLocalFrame(lat, lon Coord, height float64) *LocalFrame {
+	return &LocalFrame{
+		Latitude:  lat,
+		Longitude: lon,
+		Height:    height,
+		origin:    ECEFFromCoord(lat, lon, height),
+	}
+}
+
+// ENU will return the position of p relative to the origin
+func (f *LocalFrame) ENU(p ECEF) ENU {
+	return enuRotate(f.Latitude, f.Longitude, ECEF{X: p.X - f.origin.X, Y: p.Y - f.origin.Y, Z: p.Z - f.origin.Z})
+}
+
+// NED will return the position of p relative to the origin
+func (f *LocalFrame) NED(p ECEF) NED {
+	return f.ENU(p).NED()
+}
+
+// ECEF will return the ECEF position of a point relative to the origin
+func (f *LocalFrame) ECEF(p ENU) ECEF {
+	v := ecefRotate(f.Latitude, f.Longitude, p)
+	return ECEF{X: f.origin.X + v.X, Y: f.origin.Y + v.Y, Z: f.origin.Z + v.Z}
+}
+
+// ENUVelocity will return the horizontal velocity in meters per second for a speed in knots and a
+// course in degrees True, with climb as the vertical speed in meters per second
+func ENUVelocity(speed, course, climb float64) ENU {
+	sin, cos := math.Sincos(toRadians(course))
+	v := speed * MetersPerNauticalMile / 3600
+	return ENU{East: v * sin, North: v * cos, Up: climb}
+}
+
+// ECEFVelocity will return a velocity in the local frame at lat/lon as an ECEF velocity
+func ECEFVelocity(lat, lon Coord, v ENU) ECEF {
+	return ecefRotate(lat, lon, v)
+}
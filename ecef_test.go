@@ -0,0 +1,95 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestECEFFromCoord(t *testing.T) {
+	check := func(lat, lon Coord, h float64, exp ECEF) {
+		p := ECEFFromCoord(lat, lon, h)
+		assert.InDelta(t, exp.X, p.X, 1e-6, "X")
+		assert.InDelta(t, exp.Y, p.Y, 1e-6, "Y")
+		assert.InDelta(t, exp.Z, p.Z, 1e-6, "Z")
+
+		lat2, lon2, h2 := p.Coord()
+		assert.InDelta(t, float64(lat), float64(lat2), 1e-10, "latitude")
+		assert.InDelta(t, float64(lon), float64(lon2), 1e-10, "longitude")
+		assert.InDelta(t, h, h2, 1e-6, "height")
+	}
+
+	check(0, 0, 0, ECEF{X: WGS84A})
+	check(0, 90, 100, ECEF{Y: WGS84A + 100})
+	check(0, -180, 0, ECEF{X: -WGS84A})
+	check(90, 0, 0, ECEF{Z: WGS84B})
+	check(-90, 0, -50, ECEF{Z: -WGS84B + 50})
+	check(45, 45, 0, ECEF{X: 3194419.145061, Y: 3194419.145061, Z: 4487348.408866})
+}
+
+func TestECEF_Coord(t *testing.T) {
+	for _, c := range [][3]float64{{48.8582, 2.2945, 35}, {-33.857, 151.215, -20}, {89.9999, -120, 8848}, {-12.5, -77, 400e3}} {
+		lat, lon, h := ECEFFromCoord(Coord(c[0]), Coord(c[1]), c[2]).Coord()
+		assert.InDelta(t, c[0], float64(lat), 1e-10, "latitude")
+		assert.InDelta(t, c[1], float64(lon), 1e-10, "longitude")
+		assert.InDelta(t, c[2], h, 1e-6, "height")
+	}
+}
+
+func TestGPGGA_ECEF(t *testing.T) {
+	g := GPGGA{Latitude: 45, Longitude: 45, Altitude: 100, GeoIDHeight: -20}
+	assert.Equal(t, ECEFFromCoord(45, 45, 80), g.ECEF())
+}
+
+func TestLocalFrame(t *testing.T) {
+	f := NewLocalFrame(48.8582, 2.2945, 35)
+
+	assert.InDelta(t, 0, f.ENU(ECEFFromCoord(48.8582, 2.2945, 35)).Up, 1e-9)
+
+	enu := f.ENU(ECEFFromCoord(48.8582, 2.2945, 335))
+	assert.InDelta(t, 0, enu.East, 1e-6, "east")
+	assert.InDelta(t, 0, enu.North, 1e-6, "north")
+	assert.InDelta(t, 300, enu.Up, 1e-6, "up")
+
+	// 1km north along the meridian, dropping below the tangent plane
+	lat, lon := Destination(48.8582, 2.2945, 0, 1000)
+	enu = f.ENU(ECEFFromCoord(lat, lon, 35))
+	assert.InDelta(t, 0, enu.East, 1e-6, "east")
+	assert.InDelta(t, 1000, enu.North, 5, "north")
+	assert.True(t, enu.Up < 0 && enu.Up > -0.1, "up")
+
+	ned := f.NED(ECEFFromCoord(lat, lon, 35))
+	assert.Equal(t, enu.NED(), ned)
+	assert.Equal(t, enu, ned.ENU())
+	assert.Equal(t, enu.North, ned.North)
+	assert.Equal(t, -enu.Up, ned.Down)
+
+	p := f.ECEF(ENU{East: 100, North: -200, Up: 300})
+	enu = f.ENU(p)
+	assert.InDelta(t, 100, enu.East, 1e-6, "east")
+	assert.InDelta(t, -200, enu.North, 1e-6, "north")
+	assert.InDelta(t, 300, enu.Up, 1e-6, "up")
+}
+
+func TestENUVelocity(t *testing.T) {
+	v := ENUVelocity(10, 90, 0)
+	assert.InDelta(t, 10*MetersPerNauticalMile/3600, v.East, 1e-9, "east")
+	assert.InDelta(t, 0, v.North, 1e-9, "north")
+	assert.Equal(t, 0.0, v.Up)
+
+	v = ENUVelocity(3600/MetersPerNauticalMile, 225, -1)
+	assert.InDelta(t, -0.5*1.4142135623730951, v.East, 1e-9, "east")
+	assert.InDelta(t, -0.5*1.4142135623730951, v.North, 1e-9, "north")
+	assert.Equal(t, NED{North: v.North, East: v.East, Down: 1}, v.NED())
+
+	// at 0°N 0°E, east is +Y, north is +Z and up is +X
+	e := ECEFVelocity(0, 0, ENU{East: 1, North: 2, Up: 3})
+	assert.InDelta(t, 3, e.X, 1e-9, "X")
+	assert.InDelta(t, 1, e.Y, 1e-9, "Y")
+	assert.InDelta(t, 2, e.Z, 1e-9, "Z")
+
+	// at the north pole on the prime meridian, north is -X
+	e = ECEFVelocity(90, 0, ENU{North: 1})
+	assert.InDelta(t, -1, e.X, 1e-9, "X")
+	assert.InDelta(t, 0, e.Z, 1e-9, "Z")
+}
@@ -0,0 +1,150 @@
+package nmea
+
+import (
+	"strings"
+	"time"
+)
+
+// Stage is a step in a sentence processing pipeline, returning a reader of the sentences
+// from r that it passes on
+type Stage func(r SentenceReader) SentenceReader
+
+// Chain will apply the stages to r in order
+func Chain(r SentenceReader, stages ...Stage) SentenceReader {
+	for _, s := range stages {
+		r = s(r)
+	}
+	return r
+}
+
+// filterReader passes on sentences for which keep returns true. Errors are passed on as-is.
+type filterReader struct {
+	r    SentenceReader
+	keep func(Sentence) bool
+}
+
+func (f *filterReader) ReadSentence() (Sentence, error) {
+	for {
+		s, err := f.r.ReadSentence()
+		if err != nil || f.keep(s) {
+			return s, err
+		}
+	}
+}
+
+// Filter will return a Stage passing on only the sentences for which keep returns true
+func Filter(keep func(Sentence) bool) Stage {
+	return func(r SentenceReader) SentenceReader {
+		return &filterReader{r: r, keep: keep}
+	}
+}
+
+// FilterTypes will return a Stage passing on only sentences of the given types
+func FilterTypes(types ...Type) Stage {
+	return Filter(func(s Sentence) bool {
+		for _, t := range types {
+			if s.Type() == t {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// FilterTalkers will return a Stage passing on only sentences from the given talkers.
+// Proprietary sentences have the talker "P".
+func FilterTalkers(talkers ...string) Stage {
+	return Filter(func(s Sentence) bool {
+		talker := Talker(s)
+		for _, t := range talkers {
+			if talker == t {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// DropInvalid is a Stage that drops GPRMC sentences that are not Active and GPGGA sentences
+// with an invalid fix
+func DropInvalid(r SentenceReader) SentenceReader {
+	return Filter(func(s Sentence) bool {
+		switch v := s.(type) {
+		case *GPRMC:
+			return v.Active
+		case GPRMC:
+			return v.Active
+		case *GPGGA:
+			return v.FixType != GPGGAFixInvalid
+		case GPGGA:
+			return v.FixType != GPGGAFixInvalid
+		}
+		return true
+	})(r)
+}
+
+// Decimate will return a Stage passing on at most one sentence of each type and talker
+// per interval, such as time.Second for 1 Hz. The interval is measured by the time of the
+// sentence for GPRMC and GPGGA, so that replayed logs are decimated as they were recorded,
+// and by the time a sentence is read for other types.
+func Decimate(interval time.Duration) Stage {
+	return decimate(interval, time.Now)
+}
+
+func decimate(interval time.Duration, now func() time.Time) Stage {
+	return func(r SentenceReader) SentenceReader {
+		last := make(map[string]time.Time)
+		return &filterReader{r: r, keep: func(s Sentence) bool {
+			name := Talker(s) + string(s.Type())
+			t := fixTime(s)
+			if t.IsZero() {
+				t = now()
+			}
+			// a sentence time earlier than the last (e.g. GPGGA after midnight) restarts the interval
+			if prev, ok := last[name]; ok && !t.Before(prev) && t.Sub(prev) < interval {
+				return false
+			}
+			last[name] = t
+			return true
+		}}
+	}
+}
+
+// Deduplicate will return a Stage dropping any sentence identical to one passed on within
+// the preceding window
+func Deduplicate(window time.Duration) Stage {
+	return deduplicate(window, time.Now)
+}
+
+func deduplicate(window time.Duration, now func() time.Time) Stage {
+	return func(r SentenceReader) SentenceReader {
+		seen := make(map[string]time.Time)
+		return &filterReader{r: r, keep: func(s Sentence) bool {
+			t := now()
+			for k, v := range seen {
+				if t.Sub(v) >= window {
+					delete(seen, k)
+				}
+			}
+			str := s.String()
+			if _, ok := seen[str]; ok {
+				return false
+			}
+			seen[str] = t
+			return true
+		}}
+	}
+}
+
+// Talker will return the talker ID of a sentence, such as "GP", or "P" for proprietary sentences
+func Talker(s Sentence) string {
+	str := s.String()
+	i := strings.IndexAny(str, "$!")
+	if i == -1 || len(str) < i+3 {
+		return ""
+	}
+	if str[i+1] == 'P' {
+		return "P"
+	}
+	return str[i+1 : i+3]
+}
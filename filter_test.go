@@ -0,0 +1,139 @@
+package nmea
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sliceReader []Sentence
+
+func (r *sliceReader) ReadSentence() (Sentence, error) {
+	if len(*r) == 0 {
+		return nil, io.EOF
+	}
+	s := (*r)[0]
+	*r = (*r)[1:]
+	return s, nil
+}
+
+func readAll(t *testing.T, r SentenceReader) []Sentence {
+	var res []Sentence
+	for {
+		s, err := r.ReadSentence()
+		if err == io.EOF {
+			return res
+		}
+		assert.Nil(t, err)
+		res = append(res, s)
+	}
+}
+
+func TestTalker(t *testing.T) {
+	assert.Equal(t, "GP", Talker(GPRMC{}))
+	assert.Equal(t, "HE", Talker(HDT{}))
+	assert.Equal(t, "IN", Talker(&HDT{Talker: "IN"}))
+	assert.Equal(t, "P", Talker(Raw{TypeName: "PXYZ"}))
+	assert.Equal(t, "AI", Talker(&Raw{TypeName: "AIVDM", Tag: &TagBlock{Source: "AI0001"}}))
+}
+
+func TestChain(t *testing.T) {
+	src := sliceReader{
+		&GPRMC{Active: true},
+		&GPRMC{Active: false},
+		&GPGGA{FixType: GPGGAFixInvalid},
+		&GPGGA{FixType: GPGGAFixGPS},
+		&HDT{Talker: "HE"},
+		&HDT{Talker: "IN"},
+		&Raw{TypeName: "PXYZ"},
+	}
+	res := readAll(t, Chain(&src,
+		FilterTalkers("GP", "IN"),
+		DropInvalid,
+		FilterTypes(TypeGPRMC, TypeGPGGA, TypeHDT),
+	))
+	assert.Equal(t, []Sentence{
+		&GPRMC{Active: true},
+		&GPGGA{FixType: GPGGAFixGPS},
+		&HDT{Talker: "IN"},
+	}, res)
+}
+
+func TestDecimate(t *testing.T) {
+	var now time.Time
+	clock := func() time.Time {
+		now = now.Add(100 * time.Millisecond)
+		return now
+	}
+
+	// 10 Hz of two types
+	var src sliceReader
+	for i := 0; i < 20; i++ {
+		src = append(src, &HDT{Heading: float64(i)})
+		src = append(src, &HDT{Talker: "IN", Heading: float64(i)})
+	}
+	res := readAll(t, decimate(time.Second, clock)(&src))
+	assert.Equal(t, []Sentence{
+		&HDT{Heading: 0},
+		&HDT{Talker: "IN", Heading: 0},
+		&HDT{Heading: 5},
+		&HDT{Talker: "IN", Heading: 5},
+		&HDT{Heading: 10},
+		&HDT{Talker: "IN", Heading: 10},
+		&HDT{Heading: 15},
+		&HDT{Talker: "IN", Heading: 15},
+	}, res)
+}
+
+func TestDecimate_replay(t *testing.T) {
+	// 3 seconds of a 10 Hz log, read much faster than recorded
+	start := time.Date(2020, 9, 13, 23, 59, 58, 0, time.UTC)
+	sim := &Simulator{Waypoints: []SimWaypoint{{Latitude: 45.5, Longitude: -93.25}}, Start: start, Interval: 100 * time.Millisecond}
+	var log strings.Builder
+	for i := 0; i < 30; i++ {
+		for _, line := range sim.NextLines() {
+			log.WriteString(line + "\r\n")
+		}
+	}
+
+	res := readAll(t, Decimate(time.Second)(NewReader(strings.NewReader(log.String()))))
+	var rmc, gga []time.Time
+	for _, s := range res {
+		switch v := s.(type) {
+		case *GPRMC:
+			rmc = append(rmc, v.Time)
+		case *GPGGA:
+			gga = append(gga, v.Time)
+		}
+	}
+	assert.Equal(t, []time.Time{start, start.Add(time.Second), start.Add(2 * time.Second)}, rmc)
+	// GPGGA has no date, so its time wraps at midnight
+	if assert.Len(t, gga, 3) {
+		assert.Equal(t, 0, gga[2].Hour())
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	var now time.Time
+	clock := func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	src := sliceReader{
+		&HDT{Heading: 1},
+		&HDT{Heading: 1},
+		&HDT{Heading: 2},
+		&HDT{Heading: 1},
+		&HDT{Heading: 1},
+	}
+	res := readAll(t, deduplicate(3*time.Second, clock)(&src))
+	assert.Equal(t, []Sentence{
+		&HDT{Heading: 1},
+		&HDT{Heading: 2},
+		&HDT{Heading: 1},
+	}, res)
+}
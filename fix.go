@@ -0,0 +1,177 @@
+package nmea
+
+import (
+	"io"
+	"math"
+	"time"
+)
+
+// Fix is a position fix combining the sentences a receiver sends for a single epoch. Values that
+// were not reported are NaN.
+type Fix struct {
+	Time       time.Time // time of the fix. The date is zero until a GPRMC sentence has been seen
+	Valid      bool      // true if the receiver reported the fix as valid
+	Latitude   Coord
+	Longitude  Coord
+	Altitude   float64  // altitude in meters
+	Speed      float64  // speed over ground in knots
+	Course     float64  // course over ground in degrees True
+	Satellites int      // number of satellites used
+	HDOP       float64  // horizontal dilution of precision
+	VDOP       float64  // vertical dilution of precision
+	PDOP       float64  // position dilution of precision
+	Quality    GPGGAFix // fix quality from GPGGA, empty if none was received
+	Mode       GPGSAFix // 2D/3D mode from GPGSA, empty if none was received
+}
+
+func newFix() *Fix {
+	nan := math.NaN()
+	return &Fix{Altitude: nan, Speed: nan, Course: nan, HDOP: nan, VDOP: nan, PDOP: nan}
+}
+
+// FixAggregator combines GPRMC, GPGGA, GPGSA and VTG sentences into a Fix for each epoch.
+// Sentences with a time (GPRMC and GPGGA) start a new epoch when the time changes; GPGSA and VTG
+// are applied to the current epoch.
+type FixAggregator struct {
+	cur    *Fix
+	hasRMC bool
+	hasGGA bool
+	active bool
+	date   time.Time // date of the last GPRMC
+	last   time.Time // time of the last epoch
+}
+
+// timeOfDay will return the time since midnight
+func timeOfDay(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+// epoch will return the fix for the epoch at t, returning the previous fix if it is complete
+func (a *FixAggregator) epoch(t time.Time) *Fix {
+	if a.cur != nil && (a.hasRMC || a.hasGGA) && timeOfDay(a.cur.Time) != timeOfDay(t) {
+		done := a.Flush()
+		a.cur = newFix()
+		return done
+	}
+	if a.cur == nil {
+		a.cur = newFix()
+	}
+	return nil
+}
+
+// Update will add a sentence to the current epoch. When a sentence for a new epoch is received
+// the Fix for the previous epoch is returned, otherwise nil is returned. Other sentences are ignored.
+func (a *FixAggregator) Update(s Sentence) *Fix {
+	var done *Fix
+	switch v := s.(type) {
+	case *GPRMC:
+		done = a.epoch(v.Time)
+		a.hasRMC, a.active = true, v.Active
+		a.date = time.Date(v.Time.Year(), v.Time.Month(), v.Time.Day(), 0, 0, 0, 0, time.UTC)
+		a.cur.Time = v.Time
+		a.cur.Latitude, a.cur.Longitude = v.Latitude, v.Longitude
+		a.cur.Speed, a.cur.Course = v.Speed, v.TrueCourse
+		a.last = a.cur.Time
+	case *GPGGA:
+		done = a.epoch(v.Time)
+		if !a.hasRMC {
+			a.cur.Time = v.Time
+			if !a.date.IsZero() {
+				// GPGGA has no date, so continue from the last GPRMC
+				t := a.date.Add(timeOfDay(v.Time))
+				if t.Before(a.last.Add(-12 * time.Hour)) {
+					a.date = a.date.AddDate(0, 0, 1)
+					t = t.AddDate(0, 0, 1)
+				}
+				a.cur.Time = t
+			}
+		}
+		a.hasGGA = true
+		a.cur.Latitude, a.cur.Longitude = v.Latitude, v.Longitude
+		a.cur.Altitude = v.Altitude
+		a.cur.Satellites = v.Satellites
+		a.cur.HDOP = v.HDOP
+		a.cur.Quality = v.FixType
+		a.last = a.cur.Time
+	case *GPGSA:
+		if a.cur == nil {
+			a.cur = newFix()
+		}
+		a.cur.Mode = v.FixType
+		a.cur.PDOP, a.cur.HDOP, a.cur.VDOP = v.PDOP, v.HDOP, v.VDOP
+	case *VTG:
+		if a.cur == nil {
+			a.cur = newFix()
+		}
+		a.cur.Speed, a.cur.Course = v.Speed, v.TrueCourse
+	case GPRMC:
+		return a.Update(&v)
+	case GPGGA:
+		return a.Update(&v)
+	case GPGSA:
+		return a.Update(&v)
+	case VTG:
+		return a.Update(&v)
+	}
+	return done
+}
+
+// Flush will return the Fix for the current epoch, or nil if it has no position
+func (a *FixAggregator) Flush() *Fix {
+	f := a.cur
+	if f == nil || !(a.hasRMC || a.hasGGA) {
+		return nil
+	}
+	f.Valid = (!a.hasRMC || a.active) && (!a.hasGGA || f.Quality != GPGGAFixInvalid)
+	if f.Mode == GPGSAFixNoFix {
+		f.Valid = false
+	}
+	a.cur, a.hasRMC, a.hasGGA, a.active = nil, false, false, false
+	return f
+}
+
+// fixSegments will split fixes into runs of valid fixes, dropping invalid ones
+func fixSegments(fixes []Fix) [][]Fix {
+	var res [][]Fix
+	start := -1
+	for i, f := range fixes {
+		if f.Valid && start == -1 {
+			start = i
+		}
+		if !f.Valid && start != -1 {
+			res = append(res, fixes[start:i])
+			start = -1
+		}
+	}
+	if start != -1 {
+		res = append(res, fixes[start:])
+	}
+	return res
+}
+
+// ReadFixes will read sentences from r until io.EOF, returning the fix for each epoch.
+// Lines that can not be parsed are skipped.
+func ReadFixes(r SentenceReader) ([]Fix, error) {
+	var a FixAggregator
+	var res []Fix
+	for {
+		s, err := r.ReadSentence()
+		if _, ok := err.(*LineError); ok {
+			continue
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return res, err
+		}
+		if f := a.Update(s); f != nil {
+			res = append(res, *f)
+		}
+	}
+	if f := a.Flush(); f != nil {
+		res = append(res, *f)
+	}
+	return res, nil
+}
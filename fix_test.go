@@ -0,0 +1,62 @@
+package nmea
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixAggregator_Update(t *testing.T) {
+	t0 := time.Date(2020, 9, 13, 23, 59, 59, 0, time.UTC)
+	sim := &Simulator{Waypoints: []SimWaypoint{{Latitude: 45.51, Longitude: -93.25}}, Start: t0, Altitude: 310.5}
+
+	var a FixAggregator
+	var fixes []*Fix
+	for i := 0; i < 2; i++ {
+		for _, s := range sim.Next() {
+			if f := a.Update(s); f != nil {
+				fixes = append(fixes, f)
+			}
+		}
+	}
+	assert.Len(t, fixes, 1)
+	fixes = append(fixes, a.Flush())
+	assert.Nil(t, a.Flush())
+
+	for i, f := range fixes {
+		assert.Equal(t, t0.Add(time.Duration(i)*time.Second), f.Time)
+		assert.True(t, f.Valid)
+		assert.Equal(t, 310.5, f.Altitude)
+		assert.Equal(t, 8, f.Satellites)
+		assert.Equal(t, 0.9, f.HDOP)
+		assert.Equal(t, 1.2, f.VDOP)
+		assert.Equal(t, GPGGAFixGPS, f.Quality)
+		assert.Equal(t, GPGSAFix3D, f.Mode)
+	}
+}
+
+func TestFixAggregator_Update_gga(t *testing.T) {
+	var a FixAggregator
+	day := time.Date(2020, 9, 13, 0, 0, 0, 0, time.UTC)
+	a.Update(&GPRMC{Time: day.Add(23*time.Hour + 59*time.Minute + 59*time.Second), Active: true})
+
+	// GPGGA only, across midnight
+	f := a.Update(&GPGGA{Time: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), FixType: GPGGAFixInvalid})
+	assert.NotNil(t, f)
+	f = a.Flush()
+	if assert.NotNil(t, f) {
+		assert.Equal(t, day.AddDate(0, 0, 1), f.Time)
+		assert.False(t, f.Valid)
+		assert.True(t, math.IsNaN(f.Speed))
+		assert.True(t, math.IsNaN(f.PDOP))
+	}
+}
+
+func TestReadFixes(t *testing.T) {
+	fixes, err := ReadFixes(NewReader(strings.NewReader(gprmcStr + "\r\n$GPXXX*00\r\n" + gpggaStr + "\r\n")))
+	assert.Nil(t, err)
+	assert.Len(t, fixes, 2)
+}
@@ -0,0 +1,228 @@
+package nmea
+
+import (
+	"errors"
+	"math"
+)
+
+// Earth models. Distances are in meters and bearings in degrees True.
+const (
+	EarthRadius = 6371008.8 // mean radius of the earth in meters, used for spherical calculations
+
+	WGS84A = 6378137.0         // WGS-84 semi-major axis in meters
+	WGS84F = 1 / 298.257223563 // WGS-84 flattening
+	WGS84B = WGS84A * (1 - WGS84F)
+
+	MetersPerNauticalMile = 1852.0
+)
+
+// ErrNoConvergence is returned by VincentyInverse for nearly antipodal points, where the
+// iteration fails to converge
+var ErrNoConvergence = errors.New("vincenty formula failed to converge")
+
+func toRadians(deg float64) float64 { return deg * math.Pi / 180 }
+func toDegrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// normalizeLongitude will wrap a longitude in degrees to [-180, 180)
+func normalizeLongitude(lon float64) Coord {
+	return Coord(math.Mod(math.Mod(lon+180, 360)+360, 360) - 180)
+}
+
+// HaversineDistance will return the great-circle distance in meters between two points on a spherical earth
+func HaversineDistance(lat1, lon1, lat2, lon2 Coord) float64 {
+	p1, p2 := toRadians(float64(lat1)), toRadians(float64(lat2))
+	dp := p2 - p1
+	dl := toRadians(float64(lon2 - lon1))
+
+	a := math.Sin(dp/2)*math.Sin(dp/2) + math.Cos(p1)*math.Cos(p2)*math.Sin(dl/2)*math.Sin(dl/2)
+	return 2 * EarthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// InitialBearing will return the initial great-circle bearing from the first point to the second
+func InitialBearing(lat1, lon1, lat2, lon2 Coord) float64 {
+	p1, p2 := toRadians(float64(lat1)), toRadians(float64(lat2))
+	dl := toRadians(float64(lon2 - lon1))
+
+	y := math.Sin(dl) * math.Cos(p2)
+	x := math.Cos(p1)*math.Sin(p2) - math.Sin(p1)*math.Cos(p2)*math.Cos(dl)
+	return normalizeHeading(toDegrees(math.Atan2(y, x)))
+}
+
+// FinalBearing will return the great-circle bearing on arrival at the second point
+func FinalBearing(lat1, lon1, lat2, lon2 Coord) float64 {
+	return normalizeHeading(InitialBearing(lat2, lon2, lat1, lon1) + 180)
+}
+
+// Destination will return the point dist meters from lat/lon along the great circle with the initial bearing
+func Destination(lat, lon Coord, bearing, dist float64) (Coord, Coord) {
+	p1 := toRadians(float64(lat))
+	l1 := toRadians(float64(lon))
+	brng := toRadians(bearing)
+	ang := dist / EarthRadius
+
+	p2 := math.Asin(math.Sin(p1)*math.Cos(ang) + math.Cos(p1)*math.Sin(ang)*math.Cos(brng))
+	l2 := l1 + math.Atan2(math.Sin(brng)*math.Sin(ang)*math.Cos(p1), math.Cos(ang)-math.Sin(p1)*math.Sin(p2))
+
+	return Coord(toDegrees(p2)), normalizeLongitude(toDegrees(l2))
+}
+
+// rhumb will return the difference in latitude, the projected difference in latitude and the
+// difference in longitude (taking the shorter way around) between two points, in radians
+func rhumb(lat1, lon1, lat2, lon2 Coord) (dp, dpsi, dl float64) {
+	p1, p2 := toRadians(float64(lat1)), toRadians(float64(lat2))
+	dp = p2 - p1
+	dpsi = math.Log(math.Tan(math.Pi/4+p2/2) / math.Tan(math.Pi/4+p1/2))
+	dl = toRadians(float64(lon2 - lon1))
+	if math.Abs(dl) > math.Pi {
+		if dl > 0 {
+			dl -= 2 * math.Pi
+		} else {
+			dl += 2 * math.Pi
+		}
+	}
+	return dp, dpsi, dl
+}
+
+// RhumbDistance will return the distance in meters between two points along a rhumb line (line of constant bearing)
+func RhumbDistance(lat1, lon1, lat2, lon2 Coord) float64 {
+	dp, dpsi, dl := rhumb(lat1, lon1, lat2, lon2)
+
+	// E-W lines have no change in projected latitude
+	q := math.Cos(toRadians(float64(lat1)))
+	if math.Abs(dpsi) > 1e-12 {
+		q = dp / dpsi
+	}
+	return math.Sqrt(dp*dp+q*q*dl*dl) * EarthRadius
+}
+
+// RhumbBearing will return the constant bearing of the rhumb line from the first point to the second
+func RhumbBearing(lat1, lon1, lat2, lon2 Coord) float64 {
+	_, dpsi, dl := rhumb(lat1, lon1, lat2, lon2)
+	return normalizeHeading(toDegrees(math.Atan2(dl, dpsi)))
+}
+
+// RhumbDestination will return the point dist meters from lat/lon along a rhumb line with the bearing
+func RhumbDestination(lat, lon Coord, bearing, dist float64) (Coord, Coord) {
+	p1 := toRadians(float64(lat))
+	brng := toRadians(bearing)
+	ang := dist / EarthRadius
+
+	dp := ang * math.Cos(brng)
+	p2 := p1 + dp
+	// beyond a pole, come back down the other side
+	if math.Abs(p2) > math.Pi/2 {
+		if p2 > 0 {
+			p2 = math.Pi - p2
+		} else {
+			p2 = -math.Pi - p2
+		}
+	}
+
+	dpsi := math.Log(math.Tan(math.Pi/4+p2/2) / math.Tan(math.Pi/4+p1/2))
+	q := math.Cos(p1)
+	if math.Abs(dpsi) > 1e-12 {
+		q = dp / dpsi
+	}
+	dl := ang * math.Sin(brng) / q
+
+	return Coord(toDegrees(p2)), normalizeLongitude(float64(lon) + toDegrees(dl))
+}
+
+// VincentyInverse will return the distance in meters and the initial and final bearings between
+// two points on the WGS-84 ellipsoid, using Vincenty's inverse formula. The result is accurate
+// to within 0.5mm, but the formula fails to converge for nearly antipodal points.
+func VincentyInverse(lat1, lon1, lat2, lon2 Coord) (dist, initialBearing, finalBearing float64, err error) {
+	const f, a, b = WGS84F, WGS84A, WGS84B
+
+	// take the shorter way around, so points either side of the antimeridian converge
+	L := toRadians(float64(normalizeLongitude(float64(lon2 - lon1))))
+	tanU1 := (1 - f) * math.Tan(toRadians(float64(lat1)))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	tanU2 := (1 - f) * math.Tan(toRadians(float64(lat2)))
+	cosU2 := 1 / math.Sqrt(1+tanU2*tanU2)
+	sinU2 := tanU2 * cosU2
+
+	var sinL, cosL, sinS, cosS, sigma, cosSqA, cos2Sm float64
+	lambda := L
+	for i := 0; ; i++ {
+		if i == 1000 {
+			return 0, 0, 0, ErrNoConvergence
+		}
+		sinL, cosL = math.Sin(lambda), math.Cos(lambda)
+		sinSqS := (cosU2*sinL)*(cosU2*sinL) + (cosU1*sinU2-sinU1*cosU2*cosL)*(cosU1*sinU2-sinU1*cosU2*cosL)
+		if sinSqS == 0 {
+			// coincident points
+			return 0, 0, 0, nil
+		}
+		sinS = math.Sqrt(sinSqS)
+		cosS = sinU1*sinU2 + cosU1*cosU2*cosL
+		sigma = math.Atan2(sinS, cosS)
+		sinA := cosU1 * cosU2 * sinL / sinS
+		cosSqA = 1 - sinA*sinA
+		cos2Sm = 0 // equatorial line
+		if cosSqA != 0 {
+			cos2Sm = cosS - 2*sinU1*sinU2/cosSqA
+		}
+		C := f / 16 * cosSqA * (4 + f*(4-3*cosSqA))
+		prev := lambda
+		lambda = L + (1-C)*f*sinA*(sigma+C*sinS*(cos2Sm+C*cosS*(-1+2*cos2Sm*cos2Sm)))
+		if math.Abs(lambda) > math.Pi {
+			return 0, 0, 0, ErrNoConvergence
+		}
+		if math.Abs(lambda-prev) <= 1e-12 {
+			break
+		}
+	}
+
+	uSq := cosSqA * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	dSigma := B * sinS * (cos2Sm + B/4*(cosS*(-1+2*cos2Sm*cos2Sm)-B/6*cos2Sm*(-3+4*sinS*sinS)*(-3+4*cos2Sm*cos2Sm)))
+
+	dist = b * A * (sigma - dSigma)
+	initialBearing = normalizeHeading(toDegrees(math.Atan2(cosU2*sinL, cosU1*sinU2-sinU1*cosU2*cosL)))
+	finalBearing = normalizeHeading(toDegrees(math.Atan2(cosU1*sinL, -sinU1*cosU2+cosU1*sinU2*cosL)))
+	return dist, initialBearing, finalBearing, nil
+}
+
+// VincentyDirect will return the point dist meters from lat/lon along the geodesic with the initial
+// bearing on the WGS-84 ellipsoid, and the final bearing at that point, using Vincenty's direct formula
+func VincentyDirect(lat, lon Coord, bearing, dist float64) (lat2, lon2 Coord, finalBearing float64) {
+	const f, a, b = WGS84F, WGS84A, WGS84B
+
+	sinA1, cosA1 := math.Sincos(toRadians(bearing))
+	tanU1 := (1 - f) * math.Tan(toRadians(float64(lat)))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+
+	sigma1 := math.Atan2(tanU1, cosA1)
+	sinA := cosU1 * sinA1
+	cosSqA := 1 - sinA*sinA
+	uSq := cosSqA * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+
+	var sinS, cosS, cos2Sm float64
+	sigma := dist / (b * A)
+	for i := 0; i < 100; i++ {
+		cos2Sm = math.Cos(2*sigma1 + sigma)
+		sinS, cosS = math.Sincos(sigma)
+		dSigma := B * sinS * (cos2Sm + B/4*(cosS*(-1+2*cos2Sm*cos2Sm)-B/6*cos2Sm*(-3+4*sinS*sinS)*(-3+4*cos2Sm*cos2Sm)))
+		prev := sigma
+		sigma = dist/(b*A) + dSigma
+		if math.Abs(sigma-prev) <= 1e-12 {
+			break
+		}
+	}
+	sinS, cosS = math.Sincos(sigma)
+	cos2Sm = math.Cos(2*sigma1 + sigma)
+
+	x := sinU1*sinS - cosU1*cosS*cosA1
+	p2 := math.Atan2(sinU1*cosS+cosU1*sinS*cosA1, (1-f)*math.Sqrt(sinA*sinA+x*x))
+	lambda := math.Atan2(sinS*sinA1, cosU1*cosS-sinU1*sinS*cosA1)
+	C := f / 16 * cosSqA * (4 + f*(4-3*cosSqA))
+	L := lambda - (1-C)*f*sinA*(sigma+C*sinS*(cos2Sm+C*cosS*(-1+2*cos2Sm*cos2Sm)))
+
+	return Coord(toDegrees(p2)), normalizeLongitude(float64(lon) + toDegrees(L)), normalizeHeading(toDegrees(math.Atan2(sinA, -x)))
+}
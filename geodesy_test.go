@@ -0,0 +1,101 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dms will return the coordinate in degrees, minutes and seconds
+func dms(deg, min, sec float64, dir CoordDirection) Coord {
+	return CoordFromDMS(deg, min, sec, dir)
+}
+
+// arcsecond is a tolerance of 1 second of arc in degrees
+const arcsecond = 1.0 / 3600
+
+func TestHaversineDistance(t *testing.T) {
+	lat1, lon1 := dms(50, 3, 59, CoordDirectionNorth), dms(5, 42, 53, CoordDirectionWest)
+	lat2, lon2 := dms(58, 38, 38, CoordDirectionNorth), dms(3, 4, 12, CoordDirectionWest)
+
+	assert.InDelta(t, 968.9e3, HaversineDistance(lat1, lon1, lat2, lon2), 100, "distance")
+	assert.InDelta(t, float64(dms(9, 7, 11, CoordDirectionNorth)), InitialBearing(lat1, lon1, lat2, lon2), arcsecond, "initial bearing")
+	assert.InDelta(t, float64(dms(11, 16, 31, CoordDirectionNorth)), FinalBearing(lat1, lon1, lat2, lon2), arcsecond, "final bearing")
+
+	assert.InDelta(t, 60.04*MetersPerNauticalMile, HaversineDistance(0, 0, 0, -1), 10, "distance")
+	assert.InDelta(t, 270, InitialBearing(0, 0, 0, -1), epsilon, "bearing")
+}
+
+func TestDestination(t *testing.T) {
+	lat, lon := Destination(dms(53, 19, 14, CoordDirectionNorth), dms(1, 43, 47, CoordDirectionWest), float64(dms(96, 1, 18, CoordDirectionNorth)), 124.8e3)
+	assert.InDelta(t, float64(dms(53, 11, 18, CoordDirectionNorth)), float64(lat), arcsecond, "latitude")
+	assert.InDelta(t, float64(dms(0, 8, 0, CoordDirectionEast)), float64(lon), arcsecond, "longitude")
+
+	// across the antimeridian
+	_, lon = Destination(0, 179.5, 90, 111.2e3)
+	assert.InDelta(t, -179.5, float64(lon), 0.01, "longitude")
+}
+
+func TestRhumb(t *testing.T) {
+	lat1, lon1 := dms(50, 21, 59, CoordDirectionNorth), dms(4, 8, 2, CoordDirectionWest)
+	lat2, lon2 := dms(42, 21, 4, CoordDirectionNorth), dms(71, 2, 27, CoordDirectionWest)
+	assert.InDelta(t, 5198e3, RhumbDistance(lat1, lon1, lat2, lon2), 1e3, "distance")
+	assert.InDelta(t, float64(dms(260, 7, 38, CoordDirectionNorth)), RhumbBearing(lat1, lon1, lat2, lon2), arcsecond, "bearing")
+
+	lat, lon := RhumbDestination(dms(51, 7, 32, CoordDirectionNorth), dms(1, 20, 17, CoordDirectionEast), float64(dms(116, 38, 10, CoordDirectionNorth)), 40.23e3)
+	assert.InDelta(t, float64(dms(50, 57, 48, CoordDirectionNorth)), float64(lat), arcsecond, "latitude")
+	assert.InDelta(t, float64(dms(1, 51, 9, CoordDirectionEast)), float64(lon), arcsecond, "longitude")
+
+	// due east
+	assert.InDelta(t, 90, RhumbBearing(45, 0, 45, 1), epsilon, "bearing")
+	assert.InDelta(t, HaversineDistance(0, 0, 0, 1), RhumbDistance(0, 0, 0, 1), 1e-6, "distance")
+}
+
+// Flinders Peak to Buninyong, from Vincenty's paper (Survey Review, 1975), on WGS-84
+var (
+	flindersLat, flindersLon   = dms(37, 57, 3.72030, CoordDirectionSouth), dms(144, 25, 29.52440, CoordDirectionEast)
+	buninyongLat, buninyongLon = dms(37, 39, 10.15610, CoordDirectionSouth), dms(143, 55, 35.38390, CoordDirectionEast)
+)
+
+func TestVincentyInverse(t *testing.T) {
+	dist, initial, final, err := VincentyInverse(flindersLat, flindersLon, buninyongLat, buninyongLon)
+	assert.Nil(t, err)
+	assert.InDelta(t, 54972.271, dist, 0.001, "distance")
+	assert.InDelta(t, float64(dms(306, 52, 5.37, CoordDirectionNorth)), initial, 0.01/3600, "initial bearing")
+	assert.InDelta(t, float64(dms(307, 10, 25.07, CoordDirectionNorth)), final, 0.01/3600, "final bearing")
+
+	dist, _, _, err = VincentyInverse(flindersLat, flindersLon, flindersLat, flindersLon)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, dist)
+
+	// a quarter meridian
+	dist, _, _, err = VincentyInverse(0, 0, 90, 0)
+	assert.Nil(t, err)
+	assert.InDelta(t, 10001965.729, dist, 0.001, "distance")
+
+	// across the antimeridian
+	dist, initial, final, err = VincentyInverse(0, 179, 0, -179)
+	assert.Nil(t, err)
+	assert.InDelta(t, WGS84A*toRadians(2), dist, 0.001, "distance")
+	assert.InDelta(t, 90, initial, 1e-9, "initial bearing")
+	assert.InDelta(t, 90, final, 1e-9, "final bearing")
+	dist, initial, _, err = VincentyInverse(10, 179.9, 10.1, -179.9)
+	assert.Nil(t, err)
+	expDist, expInitial, _, _ := VincentyInverse(10, -0.1, 10.1, 0.1)
+	assert.InDelta(t, expDist, dist, 0.001, "distance")
+	assert.InDelta(t, expInitial, initial, 1e-9, "initial bearing")
+	dist, initial, _, err = VincentyInverse(0, -179, 0, 179)
+	assert.Nil(t, err)
+	assert.InDelta(t, WGS84A*toRadians(2), dist, 0.001, "distance")
+	assert.InDelta(t, 270, initial, 1e-9, "initial bearing")
+
+	_, _, _, err = VincentyInverse(0, 0, 0.5, 179.7)
+	assert.Equal(t, ErrNoConvergence, err)
+}
+
+func TestVincentyDirect(t *testing.T) {
+	lat, lon, final := VincentyDirect(flindersLat, flindersLon, float64(dms(306, 52, 5.37, CoordDirectionNorth)), 54972.271)
+	assert.InDelta(t, float64(buninyongLat), float64(lat), 0.0001/3600, "latitude")
+	assert.InDelta(t, float64(buninyongLon), float64(lon), 0.0001/3600, "longitude")
+	assert.InDelta(t, float64(dms(307, 10, 25.07, CoordDirectionNorth)), final, 0.01/3600, "final bearing")
+}
@@ -0,0 +1,112 @@
+package nmea
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"time"
+)
+
+// GeoJSONFeatureCollection is a GeoJSON (RFC 7946) FeatureCollection
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// GeoJSONFeature is a GeoJSON Feature
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   GeoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GeoJSONGeometry is a GeoJSON geometry. Coordinates are [lon, lat] or [lon, lat, alt] positions,
+// nested according to Type.
+type GeoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// geoJSONPosition will return the position of a fix
+func geoJSONPosition(f Fix) []float64 {
+	if math.IsNaN(f.Altitude) {
+		return []float64{float64(f.Longitude), float64(f.Latitude)}
+	}
+	return []float64{float64(f.Longitude), float64(f.Latitude), f.Altitude}
+}
+
+// GeoJSONFixProperties will return the properties for a fix. Keys are suffixed with their units,
+// and values that were not reported are omitted.
+//
+//	time        RFC 3339 time of the fix
+//	speed_kn    speed over ground in knots
+//	course_deg  course over ground in degrees True
+//	altitude_m  altitude in meters
+//	hdop, vdop, pdop
+//	satellites  number of satellites used
+func GeoJSONFixProperties(f Fix) map[string]interface{} {
+	props := make(map[string]interface{})
+	if !f.Time.IsZero() {
+		props["time"] = f.Time.UTC().Format(time.RFC3339Nano)
+	}
+	for key, val := range map[string]float64{
+		"speed_kn":   f.Speed,
+		"course_deg": f.Course,
+		"altitude_m": f.Altitude,
+		"hdop":       f.HDOP,
+		"vdop":       f.VDOP,
+		"pdop":       f.PDOP,
+	} {
+		if !math.IsNaN(val) {
+			props[key] = val
+		}
+	}
+	if f.Quality != "" {
+		props["satellites"] = f.Satellites
+	}
+	return props
+}
+
+// NewGeoJSON will return a FeatureCollection for the valid fixes. The first feature is the track,
+// a LineString, or a MultiLineString if it is split by loss of fix. It is followed by a Point
+// feature for each fix.
+func NewGeoJSON(name string, fixes []Fix) *GeoJSONFeatureCollection {
+	var lines [][][]float64
+	for _, seg := range fixSegments(fixes) {
+		line := make([][]float64, len(seg))
+		for i, f := range seg {
+			line[i] = geoJSONPosition(f)
+		}
+		lines = append(lines, line)
+	}
+
+	track := GeoJSONFeature{
+		Type:       "Feature",
+		Geometry:   GeoJSONGeometry{Type: "MultiLineString", Coordinates: lines},
+		Properties: map[string]interface{}{"name": name},
+	}
+	if len(lines) == 1 {
+		track.Geometry = GeoJSONGeometry{Type: "LineString", Coordinates: lines[0]}
+	}
+	if lines == nil {
+		track.Geometry.Coordinates = [][][]float64{}
+	}
+
+	fc := &GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []GeoJSONFeature{track}}
+	for _, f := range fixes {
+		if !f.Valid {
+			continue
+		}
+		fc.Features = append(fc.Features, GeoJSONFeature{
+			Type:       "Feature",
+			Geometry:   GeoJSONGeometry{Type: "Point", Coordinates: geoJSONPosition(f)},
+			Properties: GeoJSONFixProperties(f),
+		})
+	}
+	return fc
+}
+
+// Encode will write the collection as JSON to w
+func (fc *GeoJSONFeatureCollection) Encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(fc)
+}
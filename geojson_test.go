@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGeoJSON(t *testing.T) {
+	fc := NewGeoJSON("test", testFixes())
+	if !assert.Len(t, fc.Features, 4) {
+		return
+	}
+	assert.Equal(t, GeoJSONGeometry{Type: "MultiLineString", Coordinates: [][][]float64{
+		{{-93.25, 45.5, 310.5}, {-93.25, 45.51, 311}},
+		{{-93.25, 45.52}},
+	}}, fc.Features[0].Geometry)
+	assert.Equal(t, map[string]interface{}{
+		"time":       "2020-09-13T12:26:40Z",
+		"speed_kn":   10.0,
+		"course_deg": 0.0,
+		"altitude_m": 310.5,
+		"hdop":       0.9,
+		"satellites": 8,
+	}, fc.Features[1].Properties)
+	assert.Equal(t, map[string]interface{}{
+		"time":     "2020-09-13T12:26:43Z",
+		"speed_kn": 10.0,
+	}, fc.Features[3].Properties)
+
+	fc = NewGeoJSON("test", testFixes()[:2])
+	assert.Equal(t, "LineString", fc.Features[0].Geometry.Type)
+
+	var buf bytes.Buffer
+	assert.Nil(t, fc.Encode(&buf))
+	var res map[string]interface{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &res))
+	assert.Equal(t, "FeatureCollection", res["type"])
+
+	buf.Reset()
+	assert.Nil(t, NewGeoJSON("empty", nil).Encode(&buf))
+	assert.Contains(t, buf.String(), `"coordinates":[]`)
+}
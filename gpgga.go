@@ -46,10 +46,10 @@ func (g GPGGA) String() string {
 	return Raw{
 		TypeName: string(TypeGPGGA),
 		Fields: []string{
-			g.Time.Format(timeFormat),
+			formatFieldTime(g.Time),
 			g.Latitude.String(),
 			g.Latitude.Direction().LatString(),
-			g.Longitude.String(),
+			g.Longitude.LonString(),
 			g.Longitude.Direction().LongString(),
 			string(g.FixType),
 			strconv.Itoa(g.Satellites),
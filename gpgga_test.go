@@ -22,7 +22,7 @@ func TestGPGGA_Parse(t *testing.T) {
 	assert.Equal(t, "232200", g.Time.Format(timeFormat), "timestamp")
 	assert.Equal(t, TypeGPGGA, g.Type(), "type")
 	assert.Equal(t, 14.751793333333334, float64(g.Latitude), "latitude")
-	assert.Equal(t, -7.2572833333333335, float64(g.Longitude), "longitude")
+	assert.Equal(t, -23.257283333333334, float64(g.Longitude), "longitude")
 	assert.Equal(t, GPGGAFixDGPS, g.FixType)
 	assert.Equal(t, 8, g.Satellites, "satellites")
 	assert.Equal(t, 1.1, g.HDOP, "HDOP")
@@ -50,5 +50,30 @@ func TestGPGGA_String(t *testing.T) {
 		DGPSID:      "bob",
 	}.String()
 
-	assert.Equal(t, "$GPGGA,040506,1203.9,N,1203.9,W,8,4,2.3,10.4,M,12.3,M,60,bob*07", str)
+	assert.Equal(t, "$GPGGA,040506,1203.9,N,01203.9,W,8,4,2.3,10.4,M,12.3,M,60,bob*37", str)
+}
+
+func TestGPGGA_StringSubsecond(t *testing.T) {
+	str := GPGGA{
+		Time:        time.Date(0, 1, 1, 4, 5, 6, int(250*time.Millisecond), time.UTC),
+		Latitude:    Coord(12.065),
+		Longitude:   Coord(-12.065),
+		FixType:     GPGGAFixSimulation,
+		Satellites:  4,
+		HDOP:        2.3,
+		Altitude:    10.4,
+		GeoIDHeight: 12.3,
+		DGPSUpdate:  time.Minute,
+		DGPSID:      "bob",
+	}.String()
+
+	assert.Equal(t, "$GPGGA,040506.25,1203.9,N,01203.9,W,8,4,2.3,10.4,M,12.3,M,60,bob*1E", str)
+
+	r, err := ParseRaw([]byte(str))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := new(GPGGA)
+	assert.Nil(t, g.Parse(r))
+	assert.Equal(t, int(250*time.Millisecond), g.Time.Nanosecond())
 }
@@ -9,6 +9,14 @@ import (
 const timeFormat = "150405"
 const dateFormat = "020106"
 
+// formatFieldTime will format the time of day, including hundredths of a second if t has a sub-second part
+func formatFieldTime(t time.Time) string {
+	if t.Nanosecond() >= int(10*time.Millisecond) {
+		return t.Format(timeFormat + ".00")
+	}
+	return t.Format(timeFormat)
+}
+
 // GPRMCFix (fix type for GPRMC) was added in NMEA version 2.3 and indicates the kind of fix the receiver currently has. Only Autonomous and Differential represent a valid (Active) signal
 type GPRMCFix string
 
@@ -41,7 +49,6 @@ func (g GPRMC) Type() Type {
 
 // String will return a NMEA formatted string-representation of the GPRMC data
 func (g GPRMC) String() string {
-	t := g.Time.Format(timeFormat + dateFormat)
 	stat := 'V'
 	if g.Active {
 		stat = 'A'
@@ -50,15 +57,15 @@ func (g GPRMC) String() string {
 	return Raw{
 		TypeName: string(TypeGPRMC),
 		Fields: []string{
-			t[:6],
+			formatFieldTime(g.Time),
 			string(stat),
 			g.Latitude.String(),
 			g.Latitude.Direction().LatString(),
-			g.Longitude.String(),
+			g.Longitude.LonString(),
 			g.Longitude.Direction().LongString(),
 			strconv.FormatFloat(g.Speed, 'f', -1, 64),
 			strconv.FormatFloat(g.TrueCourse, 'f', -1, 64),
-			t[6:],
+			g.Time.Format(dateFormat),
 			g.Variation.String(),
 			g.Variation.Direction().LongString(),
 			string(g.FixType),
@@ -23,7 +23,7 @@ func TestGPRMC_Parse(t *testing.T) {
 	assert.Equal(t, gprmc3339, g.Time.Format(time.RFC3339Nano), "timestamp")
 	assert.Equal(t, TypeGPRMC, g.Type(), "type")
 	assert.Equal(t, 14.751793333333334, float64(g.Latitude), "latitude")
-	assert.Equal(t, -7.257278333333333, float64(g.Longitude), "longitude")
+	assert.Equal(t, -23.257278333333332, float64(g.Longitude), "longitude")
 	assert.Equal(t, 0.27, g.Speed, "speed")
 	assert.Equal(t, 232.04, g.TrueCourse, "true course")
 	assert.Zero(t, g.Variation, "variation")
@@ -46,5 +46,36 @@ func TestGPRMC_String(t *testing.T) {
 		FixType:    GPRMCFixSimulator,
 	}.String()
 
-	assert.Equal(t, "$GPRMC,040506,A,1439.25926,N,2019.26588,E,12.4,13,020103,3059.25924,W,S*0E", str)
+	assert.Equal(t, "$GPRMC,040506,A,1439.25926,N,02019.26588,E,12.4,13,020103,3059.25924,W,S*3E", str)
+}
+
+func TestGPRMC_StringSubsecond(t *testing.T) {
+	tm := time.Date(2003, 1, 2, 4, 5, 6, int(250*time.Millisecond), time.UTC)
+	str := GPRMC{
+		Time:       tm,
+		Active:     true,
+		Latitude:   Coord(14.654321),
+		Longitude:  Coord(20.321098),
+		Speed:      12.4,
+		TrueCourse: 13,
+		Variation:  Coord(-30.987654),
+		FixType:    GPRMCFixSimulator,
+	}.String()
+
+	assert.Equal(t, "$GPRMC,040506.25,A,1439.25926,N,02019.26588,E,12.4,13,020103,3059.25924,W,S*17", str)
+
+	r, err := ParseRaw([]byte(str))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := new(GPRMC)
+	assert.Nil(t, g.Parse(r))
+	assert.Equal(t, tm, g.Time)
+}
+
+func TestFormatFieldTime(t *testing.T) {
+	tm := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	assert.Equal(t, "122640", formatFieldTime(tm))
+	assert.Equal(t, "122640.10", formatFieldTime(tm.Add(100*time.Millisecond)))
+	assert.Equal(t, "122640", formatFieldTime(tm.Add(time.Millisecond)))
 }
@@ -0,0 +1,243 @@
+package nmea
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// GPX is a GPX 1.1 document
+type GPX struct {
+	XMLName   xml.Name   `xml:"http://www.topografix.com/GPX/1/1 gpx"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Waypoints []GPXPoint `xml:"wpt"`
+	Routes    []GPXRoute `xml:"rte"`
+	Tracks    []GPXTrack `xml:"trk"`
+}
+
+// GPXRoute is an ordered list of points leading to a destination
+type GPXRoute struct {
+	Name   string     `xml:"name,omitempty"`
+	Points []GPXPoint `xml:"rtept"`
+}
+
+// GPXTrack is a recorded track, made up of segments of continuous fixes
+type GPXTrack struct {
+	Name     string       `xml:"name,omitempty"`
+	Segments []GPXSegment `xml:"trkseg"`
+}
+
+// GPXSegment is a list of track points that are logically connected in order
+type GPXSegment struct {
+	Points []GPXPoint `xml:"trkpt"`
+}
+
+// GPXPoint is a waypoint, route point or track point. Optional values are nil if not present.
+type GPXPoint struct {
+	Latitude   Coord      `xml:"lat,attr"`
+	Longitude  Coord      `xml:"lon,attr"`
+	Elevation  *float64   `xml:"ele,omitempty"`
+	Time       *time.Time `xml:"time,omitempty"`
+	Name       string     `xml:"name,omitempty"`
+	Fix        string     `xml:"fix,omitempty"` // none, 2d, 3d, dgps or pps
+	Satellites *int       `xml:"sat,omitempty"`
+	HDOP       *float64   `xml:"hdop,omitempty"`
+	VDOP       *float64   `xml:"vdop,omitempty"`
+	PDOP       *float64   `xml:"pdop,omitempty"`
+}
+
+// optFloat will return a pointer to f, or nil if f is NaN
+func optFloat(f float64) *float64 {
+	if math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
+
+// GPXPointFromFix will return the track point for a fix
+func GPXPointFromFix(f Fix) GPXPoint {
+	p := GPXPoint{
+		Latitude:  f.Latitude,
+		Longitude: f.Longitude,
+		Elevation: optFloat(f.Altitude),
+		HDOP:      optFloat(f.HDOP),
+		VDOP:      optFloat(f.VDOP),
+		PDOP:      optFloat(f.PDOP),
+	}
+	if !f.Time.IsZero() {
+		t := f.Time.UTC()
+		p.Time = &t
+	}
+	if f.Quality != "" {
+		sats := f.Satellites
+		p.Satellites = &sats
+	}
+
+	switch {
+	case !f.Valid:
+		p.Fix = "none"
+	case f.Quality == GPGGAFixDGPS:
+		p.Fix = "dgps"
+	case f.Quality == GPGGAFixPPS:
+		p.Fix = "pps"
+	case f.Mode == GPGSAFix2D:
+		p.Fix = "2d"
+	case f.Mode == GPGSAFix3D:
+		p.Fix = "3d"
+	}
+	return p
+}
+
+// NewGPXTrack will return a track of the valid fixes. A new segment is started after each loss of fix.
+func NewGPXTrack(name string, fixes []Fix) GPXTrack {
+	trk := GPXTrack{Name: name}
+	for _, fixes := range fixSegments(fixes) {
+		var seg GPXSegment
+		for _, f := range fixes {
+			seg.Points = append(seg.Points, GPXPointFromFix(f))
+		}
+		trk.Segments = append(trk.Segments, seg)
+	}
+	return trk
+}
+
+// NewGPX will return a GPX document with a single track of the fixes
+func NewGPX(name string, fixes []Fix) *GPX {
+	return &GPX{Tracks: []GPXTrack{NewGPXTrack(name, fixes)}}
+}
+
+// Encode will write the document as XML to w
+func (g *GPX) Encode(w io.Writer) error {
+	doc := *g
+	if doc.Version == "" {
+		doc.Version = "1.1"
+	}
+	if doc.Creator == "" {
+		doc.Creator = "github.com/mastercactapus/nmea"
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(doc)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGPX will read a GPX document from r
+func ReadGPX(r io.Reader) (*GPX, error) {
+	g := new(GPX)
+	err := xml.NewDecoder(r).Decode(g)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Sentences will convert the document to NMEA sentences for replay. Waypoints become WPL sentences,
+// routes become the WPL sentences for their points followed by RTE sentences, and each track point
+// becomes a GPRMC and GPGGA pair, with speed and course derived from the neighboring points.
+func (g *GPX) Sentences() ([]Sentence, error) {
+	var res []Sentence
+	for _, p := range g.Waypoints {
+		res = append(res, &WPL{Latitude: p.Latitude, Longitude: p.Longitude, Name: p.Name})
+	}
+
+	for i, r := range g.Routes {
+		rt := Route{ID: r.Name, Complete: true}
+		if rt.ID == "" {
+			rt.ID = strconv.Itoa(i + 1)
+		}
+		for j, p := range r.Points {
+			wp := WPL{Latitude: p.Latitude, Longitude: p.Longitude, Name: p.Name}
+			if wp.Name == "" {
+				wp.Name = rt.ID + "-" + strconv.Itoa(j+1)
+			}
+			rt.Waypoints = append(rt.Waypoints, wp)
+			res = append(res, &wp)
+		}
+		rtes, err := rt.Split()
+		if err != nil {
+			return nil, err
+		}
+		for k := range rtes {
+			res = append(res, &rtes[k])
+		}
+	}
+
+	for _, trk := range g.Tracks {
+		for _, seg := range trk.Segments {
+			for i := range seg.Points {
+				res = append(res, trackPointSentences(seg.Points, i)...)
+			}
+		}
+	}
+	return res, nil
+}
+
+// trackPointSentences will return the GPRMC and GPGGA sentences for point i of a segment
+func trackPointSentences(points []GPXPoint, i int) []Sentence {
+	p := points[i]
+	var t time.Time
+	if p.Time != nil {
+		t = p.Time.UTC()
+	}
+
+	// speed and course from the previous point, or to the next one for the first point
+	var speed, course float64
+	a, b := i-1, i
+	if i == 0 {
+		a, b = i, i+1
+	}
+	if a >= 0 && b < len(points) && points[a].Time != nil && points[b].Time != nil {
+		pa, pb := points[a], points[b]
+		dist := HaversineDistance(pa.Latitude, pa.Longitude, pb.Latitude, pb.Longitude) / MetersPerNauticalMile
+		brng := InitialBearing(pa.Latitude, pa.Longitude, pb.Latitude, pb.Longitude)
+		if hours := pb.Time.Sub(*pa.Time).Hours(); hours > 0 {
+			speed, course = round(dist/hours, 1), round(brng, 1)
+		}
+	}
+
+	rmc := &GPRMC{
+		Time:       t,
+		Active:     p.Fix != "none",
+		Latitude:   p.Latitude,
+		Longitude:  p.Longitude,
+		Speed:      speed,
+		TrueCourse: course,
+		FixType:    GPRMCFixAutonomous,
+	}
+	gga := &GPGGA{
+		Time:      t,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		FixType:   GPGGAFixGPS,
+	}
+	switch p.Fix {
+	case "none":
+		rmc.FixType, gga.FixType = GPRMCFixNotValid, GPGGAFixInvalid
+	case "dgps":
+		rmc.FixType, gga.FixType = GPRMCFixDifferential, GPGGAFixDGPS
+	case "pps":
+		gga.FixType = GPGGAFixPPS
+	}
+	if p.Elevation != nil {
+		gga.Altitude = *p.Elevation
+	}
+	if p.Satellites != nil {
+		gga.Satellites = *p.Satellites
+	}
+	if p.HDOP != nil {
+		gga.HDOP = *p.HDOP
+	}
+	return []Sentence{rmc, gga}
+}
@@ -0,0 +1,155 @@
+package nmea
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGPXTrack(t *testing.T) {
+	t0 := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	sim := &Simulator{
+		Waypoints: []SimWaypoint{{Latitude: 45.51, Longitude: -93.25, Speed: 10}, {Latitude: 46, Longitude: -93.25}},
+		Start:     t0,
+		Altitude:  310.5,
+	}
+	var a FixAggregator
+	var fixes []Fix
+	for i := 0; i < 6; i++ {
+		if i == 2 {
+			sim.Faults.FixLoss = 1
+		} else {
+			sim.Faults.FixLoss = 0
+		}
+		for _, s := range sim.Next() {
+			if f := a.Update(s); f != nil {
+				fixes = append(fixes, *f)
+			}
+		}
+	}
+	fixes = append(fixes, *a.Flush())
+
+	trk := NewGPXTrack("test", fixes)
+	if assert.Len(t, trk.Segments, 2) {
+		assert.Len(t, trk.Segments[0].Points, 2)
+		assert.Len(t, trk.Segments[1].Points, 3)
+	}
+	p := trk.Segments[1].Points[0]
+	assert.Equal(t, t0.Add(3*time.Second), *p.Time)
+	assert.Equal(t, 310.5, *p.Elevation)
+	assert.Equal(t, 8, *p.Satellites)
+	assert.Equal(t, 0.9, *p.HDOP)
+	assert.Equal(t, "3d", p.Fix)
+
+	var buf bytes.Buffer
+	assert.Nil(t, NewGPX("test", fixes).Encode(&buf))
+	assert.Contains(t, buf.String(), `<gpx xmlns="http://www.topografix.com/GPX/1/1" version="1.1"`)
+	assert.Contains(t, buf.String(), `<time>2020-09-13T12:26:43Z</time>`)
+
+	g, err := ReadGPX(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, trk, g.Tracks[0])
+}
+
+const gpxStr = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="45.51" lon="-93.25"><name>HOME</name></wpt>
+  <rte>
+    <name>R1</name>
+    <rtept lat="45.51" lon="-93.25"><name>A</name></rtept>
+    <rtept lat="45.6" lon="-93.25"></rtept>
+  </rte>
+  <trk>
+    <trkseg>
+      <trkpt lat="45.5" lon="-93.25"><ele>310.5</ele><time>2020-09-13T12:26:40Z</time><fix>dgps</fix><sat>9</sat><hdop>0.8</hdop></trkpt>
+      <trkpt lat="45.51" lon="-93.25"><time>2020-09-13T12:30:40Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestGPX_Sentences(t *testing.T) {
+	g, err := ReadGPX(strings.NewReader(gpxStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := g.Sentences()
+	assert.Nil(t, err)
+	if !assert.Len(t, res, 8) {
+		return
+	}
+
+	assert.Equal(t, &WPL{Latitude: 45.51, Longitude: -93.25, Name: "HOME"}, res[0])
+	assert.Equal(t, "R1-2", res[2].(*WPL).Name)
+	assert.Equal(t, &RTE{Total: 1, Number: 1, Complete: true, Route: "R1", Waypoints: []string{"A", "R1-2"}}, res[3])
+
+	rmc := res[4].(*GPRMC)
+	gga := res[5].(*GPGGA)
+	assert.Equal(t, time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC), rmc.Time)
+	assert.True(t, rmc.Active)
+	assert.Equal(t, GPRMCFixDifferential, rmc.FixType)
+	assert.InDelta(t, 0.6*60/4, rmc.Speed, 0.1)
+	assert.Equal(t, 0.0, rmc.TrueCourse)
+	assert.Equal(t, GPGGAFixDGPS, gga.FixType)
+	assert.Equal(t, 310.5, gga.Altitude)
+	assert.Equal(t, 9, gga.Satellites)
+	assert.Equal(t, 0.8, gga.HDOP)
+
+	assert.Equal(t, rmc.Speed, res[6].(*GPRMC).Speed)
+}
+
+func TestGPX_SentencesEscaped(t *testing.T) {
+	r := GPXRoute{Name: "R,1"}
+	for i := 0; i < 20; i++ {
+		r.Points = append(r.Points, GPXPoint{Latitude: 45, Longitude: -93, Name: fmt.Sprintf("P,%02d!", i)})
+	}
+	res, err := (&GPX{Routes: []GPXRoute{r}}).Sentences()
+	assert.Nil(t, err)
+
+	var rtes int
+	for _, s := range res {
+		if rte, ok := s.(*RTE); ok {
+			rtes++
+			assert.True(t, len(rte.String())+2 <= MaxSentenceLength, "length")
+		}
+	}
+	assert.True(t, rtes > 1, "split")
+}
+
+func TestGPX_SentencesLongitudeAbove100(t *testing.T) {
+	g, err := ReadGPX(strings.NewReader(`<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="-33.8688" lon="151.2093"><name>SYD</name></wpt>
+  <trk><trkseg>
+    <trkpt lat="37.7749" lon="-122.4194"><time>2020-09-13T12:26:40Z</time></trkpt>
+    <trkpt lat="37.775" lon="-122.4194"><time>2020-09-13T12:26:41Z</time></trkpt>
+  </trkseg></trk>
+</gpx>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := g.Sentences()
+	assert.Nil(t, err)
+
+	var n int
+	for _, s := range res {
+		sen, err := Parse([]byte(s.String()))
+		if !assert.Nil(t, err, s.String()) {
+			continue
+		}
+		switch v := sen.(type) {
+		case *WPL:
+			n++
+			assert.InDelta(t, 151.2093, float64(v.Longitude), epsilon, "wpl longitude")
+		case *GPRMC:
+			n++
+			assert.InDelta(t, -122.4194, float64(v.Longitude), epsilon, "rmc longitude")
+		case *GPGGA:
+			n++
+			assert.InDelta(t, -122.4194, float64(v.Longitude), epsilon, "gga longitude")
+		}
+	}
+	assert.True(t, n >= 3, "sentences")
+}
@@ -0,0 +1,135 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// GSVSatellite is a single satellite in view. Missing values are NaN.
+type GSVSatellite struct {
+	PRN       string  `csv:"prn"`           // satellite ID
+	Elevation float64 `csv:"elevation_deg"` // elevation in degrees
+	Azimuth   float64 `csv:"azimuth_deg"`   // azimuth in degrees True
+	SNR       float64 `csv:"snr_dbhz"`      // signal to noise ratio in dB-Hz, NaN if not tracking
+}
+
+// GSV reports the satellites in view. Up to 4 satellites are reported per sentence, so a full set
+// is split across multiple GSV sentences.
+type GSV struct {
+	Talker     string         `csv:"talker"`  // talker ID, defaults to GP when serializing
+	Total      int            `csv:"total"`   // total number of sentences in the set
+	Number     int            `csv:"number"`  // number of this sentence, starting at 1
+	InView     int            `csv:"in_view"` // total number of satellites in view
+	Satellites []GSVSatellite `csv:"satellites"`
+	SignalID   string         `csv:"signal_id"` // NMEA 4.10 signal ID, if present
+}
+
+// SplitGSV will return the GSV sentences reporting sats
+func SplitGSV(talker string, sats []GSVSatellite) []GSV {
+	total := (len(sats) + 3) / 4
+	if total == 0 {
+		total = 1
+	}
+	res := make([]GSV, total)
+	for i := range res {
+		end := (i + 1) * 4
+		if end > len(sats) {
+			end = len(sats)
+		}
+		res[i] = GSV{
+			Talker:     talker,
+			Total:      total,
+			Number:     i + 1,
+			InView:     len(sats),
+			Satellites: sats[i*4 : end],
+		}
+	}
+	return res
+}
+
+// Type returns TypeGSV to fulfill the Sentence interface
+func (g GSV) Type() Type {
+	return TypeGSV
+}
+
+// String will provide a NMEA formatted string. If more than 4 Satellites are present, only the first 4 will be serialized
+func (g GSV) String() string {
+	fields := []string{
+		strconv.Itoa(g.Total),
+		strconv.Itoa(g.Number),
+		strconv.Itoa(g.InView),
+	}
+	for i, sat := range g.Satellites {
+		if i == 4 {
+			break
+		}
+		fields = append(fields,
+			sat.PRN,
+			formatFieldOptFloat(sat.Elevation),
+			formatFieldOptFloat(sat.Azimuth),
+			formatFieldOptFloat(sat.SNR),
+		)
+	}
+	if g.SignalID != "" {
+		fields = append(fields, g.SignalID)
+	}
+	return Raw{TypeName: typeName(g.Talker, "GP", TypeGSV), Fields: fields}.String()
+}
+
+// Parse will parse GSV data from a raw sentence struct
+func (g *GSV) Parse(r *Raw) error {
+	var err error
+	g.Talker, err = parseTalker(r, TypeGSV)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 3 {
+		return fmt.Errorf("not enough fields, need at least 3")
+	}
+
+	g.Total, err = parseFieldInt(r.Fields[0], "Total")
+	if err != nil {
+		return err
+	}
+	g.Number, err = parseFieldInt(r.Fields[1], "Number")
+	if err != nil {
+		return err
+	}
+	g.InView, err = parseFieldInt(r.Fields[2], "InView")
+	if err != nil {
+		return err
+	}
+
+	fields := r.Fields[3:]
+	g.SignalID = ""
+	if len(fields)%4 == 1 {
+		g.SignalID = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields)%4 != 0 {
+		return fmt.Errorf("invalid number of satellite fields: %d", len(fields))
+	}
+
+	g.Satellites = make([]GSVSatellite, 0, len(fields)/4)
+	for i := 0; i < len(fields); i += 4 {
+		if fields[i] == "" {
+			continue
+		}
+		sat := GSVSatellite{PRN: fields[i]}
+		sat.Elevation, err = parseFieldOptFloat(fields[i+1], "Elevation")
+		if err != nil {
+			return err
+		}
+		sat.Azimuth, err = parseFieldOptFloat(fields[i+2], "Azimuth")
+		if err != nil {
+			return err
+		}
+		sat.SNR, err = parseFieldOptFloat(fields[i+3], "SNR")
+		if err != nil {
+			return err
+		}
+		g.Satellites = append(g.Satellites, sat)
+	}
+
+	return nil
+}
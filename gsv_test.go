@@ -0,0 +1,76 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const gsvStr = "$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74"
+
+func TestGSV_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(gsvStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := new(GSV)
+	err = g.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeGSV, g.Type(), "type")
+	assert.Equal(t, "GP", g.Talker, "talker")
+	assert.Equal(t, 3, g.Total, "total")
+	assert.Equal(t, 1, g.Number, "number")
+	assert.Equal(t, 11, g.InView, "in view")
+	if assert.Len(t, g.Satellites, 4) {
+		assert.Equal(t, GSVSatellite{PRN: "03", Elevation: 3, Azimuth: 111, SNR: 0}, g.Satellites[0])
+		assert.Equal(t, GSVSatellite{PRN: "13", Elevation: 6, Azimuth: 292, SNR: 0}, g.Satellites[3])
+	}
+	assert.Equal(t, "", g.SignalID, "signal ID")
+}
+
+func TestGSV_Parse_signalID(t *testing.T) {
+	r, err := ParseRaw([]byte("$GLGSV,1,1,02,65,,,27,66,45,090,,1*44"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := new(GSV)
+	err = g.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "GL", g.Talker, "talker")
+	assert.Equal(t, "1", g.SignalID, "signal ID")
+	if assert.Len(t, g.Satellites, 2) {
+		assert.True(t, math.IsNaN(g.Satellites[0].Elevation), "elevation")
+		assert.True(t, math.IsNaN(g.Satellites[0].Azimuth), "azimuth")
+		assert.Equal(t, 27.0, g.Satellites[0].SNR, "snr")
+		assert.True(t, math.IsNaN(g.Satellites[1].SNR), "not tracking")
+	}
+}
+
+func TestGSV_String(t *testing.T) {
+	str := GSV{Total: 1, Number: 1, InView: 2, Satellites: []GSVSatellite{
+		{PRN: "03", Elevation: 3, Azimuth: 111, SNR: 42},
+		{PRN: "04", Elevation: 15, Azimuth: 270, SNR: math.NaN()},
+	}}.String()
+
+	assert.Equal(t, "$GPGSV,1,1,2,03,3,111,42,04,15,270,*79", str)
+}
+
+func TestSplitGSV(t *testing.T) {
+	sats := make([]GSVSatellite, 9)
+	res := SplitGSV("GN", sats)
+	if assert.Len(t, res, 3) {
+		assert.Equal(t, 3, res[2].Total)
+		assert.Equal(t, 3, res[2].Number)
+		assert.Equal(t, 9, res[2].InView)
+		assert.Len(t, res[1].Satellites, 4)
+		assert.Len(t, res[2].Satellites, 1)
+	}
+
+	res = SplitGSV("", nil)
+	assert.Equal(t, []GSV{{Total: 1, Number: 1}}, res)
+}
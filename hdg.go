@@ -0,0 +1,74 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// HDG reports the heading from a magnetic sensor along with the deviation and variation needed to correct it
+type HDG struct {
+	Talker    string  `csv:"talker"`        // talker ID, defaults to HC (magnetic compass) when serializing
+	Heading   float64 `csv:"heading_deg"`   // magnetic sensor (compass) heading in degrees
+	Deviation Coord   `csv:"deviation_deg"` // magnetic deviation in degrees, East is positive
+	Variation Coord   `csv:"variation_deg"` // magnetic variation in degrees, East is positive
+}
+
+// Type returns TypeHDG to fulfill the Sentence interface
+func (h HDG) Type() Type {
+	return TypeHDG
+}
+
+// Magnetic will return the heading in degrees Magnetic, corrected for deviation
+func (h HDG) Magnetic() float64 {
+	return MagneticFromCompass(h.Heading, h.Deviation)
+}
+
+// True will return the heading in degrees True, corrected for deviation and variation
+func (h HDG) True() float64 {
+	return TrueFromMagnetic(h.Magnetic(), h.Variation)
+}
+
+// String will provide a NMEA formatted string
+func (h HDG) String() string {
+	dev, devDir := formatFieldDegreesEW(h.Deviation)
+	vari, variDir := formatFieldDegreesEW(h.Variation)
+	return Raw{
+		TypeName: typeName(h.Talker, "HC", TypeHDG),
+		Fields: []string{
+			strconv.FormatFloat(h.Heading, 'f', -1, 64),
+			dev,
+			devDir,
+			vari,
+			variDir,
+		},
+	}.String()
+}
+
+// Parse will parse HDG data from a raw sentence struct
+func (h *HDG) Parse(r *Raw) error {
+	var err error
+	h.Talker, err = parseTalker(r, TypeHDG)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	h.Heading, err = parseFieldFloat(r.Fields[0], "Heading")
+	if err != nil {
+		return err
+	}
+
+	h.Deviation, err = parseFieldDegreesEW(r.Fields[1], r.Fields[2], "deviation")
+	if err != nil {
+		return err
+	}
+
+	h.Variation, err = parseFieldDegreesEW(r.Fields[3], r.Fields[4], "variation")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hdgStr = "$HCHDG,98.3,0.0,E,12.6,W*57"
+
+func TestHDG_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(hdgStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := new(HDG)
+	err = h.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeHDG, h.Type(), "type")
+	assert.Equal(t, "HC", h.Talker, "talker")
+	assert.Equal(t, 98.3, h.Heading, "heading")
+	assert.Zero(t, h.Deviation, "deviation")
+	assert.Equal(t, -12.6, float64(h.Variation), "variation")
+	assert.InDelta(t, 98.3, h.Magnetic(), epsilon, "magnetic")
+	assert.InDelta(t, 85.7, h.True(), epsilon, "true")
+}
+
+func TestHDG_String(t *testing.T) {
+	str := HDG{
+		Heading:   350,
+		Deviation: Coord(-1.5),
+		Variation: Coord(3),
+	}.String()
+
+	assert.Equal(t, "$HCHDG,350,1.5,W,3,E*51", str)
+}
@@ -0,0 +1,50 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// HDM reports the magnetic heading of the vessel
+type HDM struct {
+	Talker  string  `csv:"talker"`               // talker ID, defaults to HC (magnetic compass) when serializing
+	Heading float64 `csv:"heading_magnetic_deg"` // heading in degrees Magnetic
+}
+
+// Type returns TypeHDM to fulfill the Sentence interface
+func (h HDM) Type() Type {
+	return TypeHDM
+}
+
+// String will provide a NMEA formatted string
+func (h HDM) String() string {
+	return Raw{
+		TypeName: typeName(h.Talker, "HC", TypeHDM),
+		Fields: []string{
+			strconv.FormatFloat(h.Heading, 'f', -1, 64),
+			"M",
+		},
+	}.String()
+}
+
+// Parse will parse HDM data from a raw sentence struct
+func (h *HDM) Parse(r *Raw) error {
+	var err error
+	h.Talker, err = parseTalker(r, TypeHDM)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	h.Heading, err = parseFieldFloat(r.Fields[0], "Heading")
+	if err != nil {
+		return err
+	}
+	if r.Fields[1] != "" && r.Fields[1] != "M" {
+		return fmt.Errorf("unknown reference for Heading: %s", r.Fields[1])
+	}
+
+	return nil
+}
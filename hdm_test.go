@@ -0,0 +1,30 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hdmStr = "$HCHDM,238.5,M*25"
+
+func TestHDM_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(hdmStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := new(HDM)
+	err = h.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeHDM, h.Type(), "type")
+	assert.Equal(t, "HC", h.Talker, "talker")
+	assert.Equal(t, 238.5, h.Heading, "heading")
+}
+
+func TestHDM_String(t *testing.T) {
+	str := HDM{Heading: 12}.String()
+
+	assert.Equal(t, "$HCHDM,12,M*04", str)
+}
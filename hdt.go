@@ -0,0 +1,50 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// HDT reports the true heading of the vessel, typically from a gyro compass
+type HDT struct {
+	Talker  string  `csv:"talker"`           // talker ID, defaults to HE (gyro, north seeking) when serializing
+	Heading float64 `csv:"heading_true_deg"` // heading in degrees True
+}
+
+// Type returns TypeHDT to fulfill the Sentence interface
+func (h HDT) Type() Type {
+	return TypeHDT
+}
+
+// String will provide a NMEA formatted string
+func (h HDT) String() string {
+	return Raw{
+		TypeName: typeName(h.Talker, "HE", TypeHDT),
+		Fields: []string{
+			strconv.FormatFloat(h.Heading, 'f', -1, 64),
+			"T",
+		},
+	}.String()
+}
+
+// Parse will parse HDT data from a raw sentence struct
+func (h *HDT) Parse(r *Raw) error {
+	var err error
+	h.Talker, err = parseTalker(r, TypeHDT)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	h.Heading, err = parseFieldFloat(r.Fields[0], "Heading")
+	if err != nil {
+		return err
+	}
+	if r.Fields[1] != "" && r.Fields[1] != "T" {
+		return fmt.Errorf("unknown reference for Heading: %s", r.Fields[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hdtStr = "$HEHDT,274.07,T*19"
+
+func TestHDT_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(hdtStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := new(HDT)
+	err = h.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeHDT, h.Type(), "type")
+	assert.Equal(t, "HE", h.Talker, "talker")
+	assert.Equal(t, 274.07, h.Heading, "heading")
+}
+
+func TestHDT_String(t *testing.T) {
+	str := HDT{Heading: 91.5}.String()
+
+	assert.Equal(t, "$HEHDT,91.5,T*12", str)
+}
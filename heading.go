@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// normalizeHeading will wrap a heading in degrees to the range [0, 360)
+func normalizeHeading(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// TrueFromMagnetic will convert a magnetic heading to a true heading given the magnetic variation
+func TrueFromMagnetic(magnetic float64, variation Coord) float64 {
+	return normalizeHeading(magnetic + float64(variation))
+}
+
+// MagneticFromTrue will convert a true heading to a magnetic heading given the magnetic variation
+func MagneticFromTrue(heading float64, variation Coord) float64 {
+	return normalizeHeading(heading - float64(variation))
+}
+
+// MagneticFromCompass will convert a compass heading to a magnetic heading given the compass deviation
+func MagneticFromCompass(compass float64, deviation Coord) float64 {
+	return normalizeHeading(compass + float64(deviation))
+}
+
+// CompassFromMagnetic will convert a magnetic heading to a compass heading given the compass deviation
+func CompassFromMagnetic(magnetic float64, deviation Coord) float64 {
+	return normalizeHeading(magnetic - float64(deviation))
+}
+
+// Bearing is a direction in degrees referenced to either True or Magnetic north
+type Bearing struct {
+	Degrees  float64 `csv:"deg"`
+	Magnetic bool    `csv:"magnetic"` // true if Degrees is Magnetic, otherwise True
+}
+
+// True will return the bearing in degrees True, converting from Magnetic with the given variation if needed
+func (b Bearing) True(variation Coord) float64 {
+	if b.Magnetic {
+		return TrueFromMagnetic(b.Degrees, variation)
+	}
+	return b.Degrees
+}
+
+func (b Bearing) fields() (val, ref string) {
+	ref = "T"
+	if b.Magnetic {
+		ref = "M"
+	}
+	return strconv.FormatFloat(b.Degrees, 'f', -1, 64), ref
+}
+
+func parseFieldBearing(val, ref, name string) (Bearing, error) {
+	deg, err := parseFieldFloat(val, name)
+	if err != nil {
+		return Bearing{}, err
+	}
+	switch ref {
+	case "T", "":
+		return Bearing{Degrees: deg}, nil
+	case "M":
+		return Bearing{Degrees: deg, Magnetic: true}, nil
+	default:
+		return Bearing{}, fmt.Errorf("unknown reference for %s: %s", name, ref)
+	}
+}
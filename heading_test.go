@@ -0,0 +1,14 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadingConversion(t *testing.T) {
+	assert.InDelta(t, 2, TrueFromMagnetic(355, Coord(7)), epsilon)
+	assert.InDelta(t, 355, MagneticFromTrue(2, Coord(7)), epsilon)
+	assert.InDelta(t, 358, MagneticFromCompass(3, Coord(-5)), epsilon)
+	assert.InDelta(t, 3, CompassFromMagnetic(358, Coord(-5)), epsilon)
+}
@@ -0,0 +1,122 @@
+package nmea
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KML is a KML 2.2 document showing a track as a line, with a timestamped placemark for each fix
+type KML struct {
+	XMLName  xml.Name    `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Document KMLDocument `xml:"Document"`
+}
+
+// KMLDocument is the container for the placemarks of a KML document
+type KMLDocument struct {
+	Name       string         `xml:"name,omitempty"`
+	Placemarks []KMLPlacemark `xml:"Placemark"`
+}
+
+// KMLPlacemark is a feature with a geometry. Track placemarks have one LineString for each segment.
+type KMLPlacemark struct {
+	Name        string        `xml:"name,omitempty"`
+	Description string        `xml:"description,omitempty"`
+	TimeStamp   *KMLTimeStamp `xml:"TimeStamp"`
+	Point       *KMLGeometry  `xml:"Point"`
+	LineStrings []KMLGeometry `xml:"MultiGeometry>LineString"`
+}
+
+// KMLTimeStamp is the time of a placemark
+type KMLTimeStamp struct {
+	When time.Time `xml:"when"`
+}
+
+// KMLGeometry is a Point or LineString. Coordinates are written as lon,lat[,alt] tuples separated by spaces.
+type KMLGeometry struct {
+	AltitudeMode string `xml:"altitudeMode,omitempty"`
+	Coordinates  string `xml:"coordinates"`
+}
+
+// kmlGeometry will return the geometry for the fixes, using absolute altitudes if all fixes have one
+func kmlGeometry(fixes []Fix) KMLGeometry {
+	var g KMLGeometry
+	hasAlt := true
+	for _, f := range fixes {
+		hasAlt = hasAlt && !math.IsNaN(f.Altitude)
+	}
+	if hasAlt {
+		g.AltitudeMode = "absolute"
+	}
+
+	coords := make([]string, len(fixes))
+	for i, f := range fixes {
+		c := strconv.FormatFloat(float64(f.Longitude), 'f', -1, 64) + "," + strconv.FormatFloat(float64(f.Latitude), 'f', -1, 64)
+		if hasAlt {
+			c += "," + strconv.FormatFloat(f.Altitude, 'f', -1, 64)
+		}
+		coords[i] = c
+	}
+	g.Coordinates = strings.Join(coords, " ")
+	return g
+}
+
+// NewKML will return a KML document for the valid fixes. The track line is split after each loss of fix.
+func NewKML(name string, fixes []Fix) *KML {
+	k := &KML{Document: KMLDocument{Name: name}}
+	track := KMLPlacemark{Name: name}
+	for _, seg := range fixSegments(fixes) {
+		track.LineStrings = append(track.LineStrings, kmlGeometry(seg))
+	}
+	k.Document.Placemarks = append(k.Document.Placemarks, track)
+
+	for _, f := range fixes {
+		if !f.Valid {
+			continue
+		}
+		pt := kmlGeometry([]Fix{f})
+		p := KMLPlacemark{Point: &pt, Description: fixDescription(f)}
+		if !f.Time.IsZero() {
+			p.TimeStamp = &KMLTimeStamp{When: f.Time.UTC()}
+		}
+		k.Document.Placemarks = append(k.Document.Placemarks, p)
+	}
+	return k
+}
+
+// fixDescription will describe the speed, course and precision of a fix
+func fixDescription(f Fix) string {
+	var parts []string
+	if !math.IsNaN(f.Speed) {
+		parts = append(parts, "Speed: "+strconv.FormatFloat(f.Speed, 'f', -1, 64)+" kn")
+	}
+	if !math.IsNaN(f.Course) {
+		parts = append(parts, "Course: "+strconv.FormatFloat(f.Course, 'f', -1, 64)+"°")
+	}
+	if !math.IsNaN(f.HDOP) {
+		parts = append(parts, "HDOP: "+strconv.FormatFloat(f.HDOP, 'f', -1, 64))
+	}
+	if f.Quality != "" {
+		parts = append(parts, "Satellites: "+strconv.Itoa(f.Satellites))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Encode will write the document as XML to w
+func (k *KML) Encode(w io.Writer) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(k)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,48 @@
+package nmea
+
+import (
+	"bytes"
+	"encoding/xml"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFixes() []Fix {
+	t0 := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	nan := math.NaN()
+	return []Fix{
+		{Time: t0, Valid: true, Latitude: 45.5, Longitude: -93.25, Altitude: 310.5, Speed: 10, Course: 0, HDOP: 0.9, VDOP: nan, PDOP: nan, Satellites: 8, Quality: GPGGAFixGPS},
+		{Time: t0.Add(time.Second), Valid: true, Latitude: 45.51, Longitude: -93.25, Altitude: 311, Speed: 10, Course: 0, HDOP: 0.9, VDOP: nan, PDOP: nan, Satellites: 8, Quality: GPGGAFixGPS},
+		{Time: t0.Add(2 * time.Second), Altitude: nan, Speed: nan, Course: nan, HDOP: nan, VDOP: nan, PDOP: nan, Quality: GPGGAFixInvalid},
+		{Time: t0.Add(3 * time.Second), Valid: true, Latitude: 45.52, Longitude: -93.25, Altitude: nan, Speed: 10, Course: nan, HDOP: nan, VDOP: nan, PDOP: nan},
+	}
+}
+
+func TestNewKML(t *testing.T) {
+	k := NewKML("test", testFixes())
+	if !assert.Len(t, k.Document.Placemarks, 4) {
+		return
+	}
+	assert.Equal(t, []KMLGeometry{
+		{AltitudeMode: "absolute", Coordinates: "-93.25,45.5,310.5 -93.25,45.51,311"},
+		{Coordinates: "-93.25,45.52"},
+	}, k.Document.Placemarks[0].LineStrings)
+
+	p := k.Document.Placemarks[1]
+	assert.Equal(t, time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC), p.TimeStamp.When)
+	assert.Equal(t, "-93.25,45.5,310.5", p.Point.Coordinates)
+	assert.Equal(t, "Speed: 10 kn, Course: 0°, HDOP: 0.9, Satellites: 8", p.Description)
+
+	var buf bytes.Buffer
+	assert.Nil(t, k.Encode(&buf))
+	assert.Contains(t, buf.String(), `<kml xmlns="http://www.opengis.net/kml/2.2">`)
+	assert.Contains(t, buf.String(), `<when>2020-09-13T12:26:40Z</when>`)
+	assert.Contains(t, buf.String(), `<MultiGeometry>`)
+
+	var res KML
+	assert.Nil(t, xml.Unmarshal(buf.Bytes(), &res))
+	assert.Equal(t, k.Document.Placemarks[0].LineStrings, res.Document.Placemarks[0].LineStrings)
+}
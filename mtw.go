@@ -0,0 +1,47 @@
+package nmea
+
+import "fmt"
+
+// MTW reports the water temperature
+type MTW struct {
+	Talker      string  `csv:"talker"`        // talker ID, defaults to YX (transducer) when serializing
+	Temperature float64 `csv:"temperature_c"` // temperature in degrees Celsius, NaN if not reported
+}
+
+// Type returns TypeMTW to fulfill the Sentence interface
+func (m MTW) Type() Type {
+	return TypeMTW
+}
+
+// String will provide a NMEA formatted string
+func (m MTW) String() string {
+	return Raw{
+		TypeName: typeName(m.Talker, "YX", TypeMTW),
+		Fields: []string{
+			formatFieldOptFloat(m.Temperature),
+			"C",
+		},
+	}.String()
+}
+
+// Parse will parse MTW data from a raw sentence struct
+func (m *MTW) Parse(r *Raw) error {
+	var err error
+	m.Talker, err = parseTalker(r, TypeMTW)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	m.Temperature, err = parseFieldOptFloat(r.Fields[0], "Temperature")
+	if err != nil {
+		return err
+	}
+	if r.Fields[0] != "" && r.Fields[1] != "" && r.Fields[1] != "C" {
+		return fmt.Errorf("unknown unit for Temperature: %s", r.Fields[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mtwStr = "$YXMTW,17.5,C*11"
+
+func TestMTW_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(mtwStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(MTW)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeMTW, d.Type(), "type")
+	assert.Equal(t, 17.5, d.Temperature, "temperature")
+}
+
+func TestMTW_String(t *testing.T) {
+	str := MTW{Temperature: -1.25}.String()
+
+	assert.Equal(t, "$YXMTW,-1.25,C*39", str)
+}
+
+func TestMTW_empty(t *testing.T) {
+	r, err := ParseRaw([]byte("$YXMTW,,C*0C"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(MTW)
+	assert.Nil(t, d.Parse(r))
+	assert.True(t, math.IsNaN(d.Temperature), "temperature")
+	assert.Equal(t, "$YXMTW,,C*0C", d.String())
+}
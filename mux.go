@@ -0,0 +1,235 @@
+package nmea
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// SourceError is returned by Multiplexer.ReadSource when a source fails. The remaining
+// sources continue to be read.
+type SourceError struct {
+	Source string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return "source " + e.Source + ": " + e.Err.Error()
+}
+
+// Multiplexer merges sentences from multiple sources into a single stream. For each sentence type
+// only the sentences from one source, the primary, are passed on. The primary is the most preferred
+// source that has sent that type recently and whose last GPGGA fix is not invalid; when it goes
+// stale or loses its fix the next source takes over, and it takes back over once it recovers.
+//
+// Sentences carrying a fix time (GPRMC and GPGGA) are also deduplicated by epoch, so that a
+// failover never repeats or rewinds a fix that was already passed on.
+type Multiplexer struct {
+	// Priority lists source names, most preferred first. Sources not listed are
+	// preferred least, in the order they are first seen.
+	Priority []string
+
+	// StaleAfter is how long a source may go without sending a sentence type before it
+	// is no longer considered for that type. Defaults to 2 seconds.
+	StaleAfter time.Duration
+
+	mx      sync.Mutex
+	order   []string
+	seen    map[Type]map[string]time.Time
+	invalid map[string]bool
+	primary map[Type]string
+	epochs  map[Type]time.Time
+
+	items  chan muxItem
+	active int
+}
+
+type muxItem struct {
+	source string
+	s      Sentence
+	err    error
+}
+
+// Accept will record that s was received from source at time t and report whether it
+// should be passed on.
+func (m *Multiplexer) Accept(source string, s Sentence, t time.Time) bool {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.seen == nil {
+		m.seen = make(map[Type]map[string]time.Time)
+		m.invalid = make(map[string]bool)
+		m.primary = make(map[Type]string)
+		m.epochs = make(map[Type]time.Time)
+	}
+	m.addSource(source)
+
+	typ := s.Type()
+	if m.seen[typ] == nil {
+		m.seen[typ] = make(map[string]time.Time)
+	}
+	m.seen[typ][source] = t
+	switch v := s.(type) {
+	case *GPGGA:
+		m.invalid[source] = v.FixType == GPGGAFixInvalid
+	case GPGGA:
+		m.invalid[source] = v.FixType == GPGGAFixInvalid
+	}
+
+	m.primary[typ] = m.selectPrimary(typ, t)
+	if m.primary[typ] != source {
+		return false
+	}
+
+	epoch := fixTime(s)
+	if epoch.IsZero() {
+		return true
+	}
+	last, ok := m.epochs[typ]
+	if ok {
+		// GPGGA has no date, so a fix more than 12 hours older is taken to be after midnight
+		d := epoch.Sub(last)
+		if d <= 0 && d > -12*time.Hour {
+			return false
+		}
+	}
+	m.epochs[typ] = epoch
+	return true
+}
+
+func (m *Multiplexer) addSource(source string) {
+	for _, name := range m.order {
+		if name == source {
+			return
+		}
+	}
+
+	// keep sources in priority order
+	rank := func(name string) int {
+		for i, p := range m.Priority {
+			if p == name {
+				return i
+			}
+		}
+		return len(m.Priority)
+	}
+	r := rank(source)
+	i := len(m.order)
+	for i > 0 && rank(m.order[i-1]) > r {
+		i--
+	}
+	m.order = append(m.order, "")
+	copy(m.order[i+1:], m.order[i:])
+	m.order[i] = source
+}
+
+func (m *Multiplexer) selectPrimary(typ Type, t time.Time) string {
+	stale := m.StaleAfter
+	if stale <= 0 {
+		stale = 2 * time.Second
+	}
+	var fallback string
+	for _, name := range m.order {
+		last, ok := m.seen[typ][name]
+		if !ok || t.Sub(last) > stale {
+			continue
+		}
+		if !m.invalid[name] {
+			return name
+		}
+		if fallback == "" {
+			fallback = name
+		}
+	}
+
+	// no source has a valid fix, stay with the most preferred live one
+	return fallback
+}
+
+// Primary will return the name of the source currently selected for the sentence type
+func (m *Multiplexer) Primary(typ Type) string {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return m.primary[typ]
+}
+
+// fixTime will return the time of the fix for sentences that carry one
+func fixTime(s Sentence) time.Time {
+	switch v := s.(type) {
+	case *GPRMC:
+		return v.Time
+	case GPRMC:
+		return v.Time
+	case *GPGGA:
+		return v.Time
+	case GPGGA:
+		return v.Time
+	}
+	return time.Time{}
+}
+
+// Add will start reading sentences from r, identified by name. Lines that can not be
+// parsed are dropped.
+func (m *Multiplexer) Add(name string, r SentenceReader) {
+	m.mx.Lock()
+	if m.items == nil {
+		m.items = make(chan muxItem, 64)
+	}
+	m.active++
+	items := m.items
+	m.mx.Unlock()
+
+	go func() {
+		for {
+			s, err := r.ReadSentence()
+			if _, ok := err.(*LineError); ok {
+				continue
+			}
+			items <- muxItem{source: name, s: s, err: err}
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// ReadSource will return the next sentence passed on from the added sources, along with the
+// name of the source it came from. If a source fails a *SourceError is returned and reading
+// may continue. Once every source has ended, io.EOF is returned.
+func (m *Multiplexer) ReadSource() (Sentence, string, error) {
+	m.mx.Lock()
+	items := m.items
+	m.mx.Unlock()
+	if items == nil {
+		return nil, "", io.EOF
+	}
+
+	for {
+		m.mx.Lock()
+		active := m.active
+		m.mx.Unlock()
+		if active == 0 {
+			return nil, "", io.EOF
+		}
+
+		item := <-items
+		if item.err != nil {
+			m.mx.Lock()
+			m.active--
+			m.mx.Unlock()
+			if item.err == io.EOF {
+				continue
+			}
+			return nil, item.source, &SourceError{Source: item.source, Err: item.err}
+		}
+		if m.Accept(item.source, item.s, time.Now()) {
+			return item.s, item.source, nil
+		}
+	}
+}
+
+// ReadSentence will return the next sentence passed on from the added sources
+func (m *Multiplexer) ReadSentence() (Sentence, error) {
+	s, _, err := m.ReadSource()
+	return s, err
+}
@@ -0,0 +1,84 @@
+package nmea
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplexer_Accept(t *testing.T) {
+	m := &Multiplexer{Priority: []string{"gps1", "gps2"}, StaleAfter: 2 * time.Second}
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	gga := func(sec int, fix GPGGAFix) *GPGGA {
+		return &GPGGA{Time: time.Date(0, 1, 1, 12, 0, sec, 0, time.UTC), FixType: fix}
+	}
+
+	// backup seen first is used until the primary appears
+	assert.True(t, m.Accept("backup", gga(0, GPGGAFixGPS), t0))
+	assert.True(t, m.Accept("gps2", gga(1, GPGGAFixGPS), t0.Add(time.Second)))
+	assert.False(t, m.Accept("backup", gga(1, GPGGAFixGPS), t0.Add(time.Second)))
+	assert.True(t, m.Accept("gps1", gga(2, GPGGAFixGPS), t0.Add(2*time.Second)))
+	assert.Equal(t, "gps1", m.Primary(TypeGPGGA))
+
+	// same epoch from another source is a duplicate
+	assert.False(t, m.Accept("gps2", gga(2, GPGGAFixGPS), t0.Add(2*time.Second)))
+
+	// primary loses its fix
+	assert.False(t, m.Accept("gps1", gga(3, GPGGAFixInvalid), t0.Add(3*time.Second)))
+	assert.True(t, m.Accept("gps2", gga(3, GPGGAFixDGPS), t0.Add(3*time.Second)))
+	assert.Equal(t, "gps2", m.Primary(TypeGPGGA))
+
+	// and recovers
+	assert.True(t, m.Accept("gps1", gga(4, GPGGAFixGPS), t0.Add(4*time.Second)))
+	assert.Equal(t, "gps1", m.Primary(TypeGPGGA))
+
+	// primary goes stale, the backup's older epoch is not repeated
+	assert.False(t, m.Accept("gps2", gga(4, GPGGAFixGPS), t0.Add(7*time.Second)))
+	assert.True(t, m.Accept("gps2", gga(5, GPGGAFixGPS), t0.Add(8*time.Second)))
+	assert.Equal(t, "gps2", m.Primary(TypeGPGGA))
+
+	// types are selected independently
+	assert.True(t, m.Accept("backup", &HDT{Heading: 1}, t0.Add(8*time.Second)))
+	assert.Equal(t, "backup", m.Primary(TypeHDT))
+}
+
+func TestMultiplexer_Accept_midnight(t *testing.T) {
+	var m Multiplexer
+	t0 := time.Now()
+	assert.True(t, m.Accept("a", &GPGGA{Time: time.Date(0, 1, 1, 23, 59, 59, 0, time.UTC)}, t0))
+	assert.True(t, m.Accept("a", &GPGGA{Time: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)}, t0))
+}
+
+type errReader struct{ err error }
+
+func (r errReader) ReadSentence() (Sentence, error) { return nil, r.err }
+
+func TestMultiplexer_ReadSource(t *testing.T) {
+	m := &Multiplexer{Priority: []string{"a"}}
+	m.Add("a", NewReader(strings.NewReader(gprmcStr+"\r\n$GPXXX*00\r\n")))
+	m.Add("b", errReader{errors.New("port closed")})
+
+	var sources []string
+	var srcErr *SourceError
+	for {
+		s, source, err := m.ReadSource()
+		if err == io.EOF {
+			break
+		}
+		if e, ok := err.(*SourceError); ok {
+			srcErr = e
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, TypeGPRMC, s.Type())
+		sources = append(sources, source)
+	}
+	assert.Equal(t, []string{"a"}, sources)
+	if assert.NotNil(t, srcErr) {
+		assert.Equal(t, "b", srcErr.Source)
+	}
+}
@@ -0,0 +1,83 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MWD reports the direction the wind is blowing from and its speed, relative to the earth
+type MWD struct {
+	Talker            string  `csv:"talker"`                 // talker ID, defaults to WI (weather instruments) when serializing
+	DirectionTrue     float64 `csv:"direction_true_deg"`     // wind direction in degrees True
+	DirectionMagnetic float64 `csv:"direction_magnetic_deg"` // wind direction in degrees Magnetic, NaN if not reported
+	Speed             float64 `csv:"speed_kn"`               // wind speed in knots
+}
+
+// Type returns TypeMWD to fulfill the Sentence interface
+func (m MWD) Type() Type {
+	return TypeMWD
+}
+
+// String will provide a NMEA formatted string. Speed is written in both knots and meters per second
+func (m MWD) String() string {
+	return Raw{
+		TypeName: typeName(m.Talker, "WI", TypeMWD),
+		Fields: []string{
+			strconv.FormatFloat(m.DirectionTrue, 'f', -1, 64),
+			"T",
+			formatFieldOptFloat(m.DirectionMagnetic),
+			"M",
+			strconv.FormatFloat(m.Speed, 'f', -1, 64),
+			string(SpeedUnitKnots),
+			strconv.FormatFloat(ConvertSpeed(m.Speed, SpeedUnitKnots, SpeedUnitMetersPerSecond), 'f', 2, 64),
+			string(SpeedUnitMetersPerSecond),
+		},
+	}.String()
+}
+
+// Parse will parse MWD data from a raw sentence struct. If speed in knots is missing, it is calculated from meters per second
+func (m *MWD) Parse(r *Raw) error {
+	var err error
+	m.Talker, err = parseTalker(r, TypeMWD)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 8 {
+		return fmt.Errorf("not enough fields, need at least 8")
+	}
+
+	m.DirectionTrue, err = parseFieldFloat(r.Fields[0], "DirectionTrue")
+	if err != nil {
+		return err
+	}
+	m.DirectionMagnetic, err = parseFieldOptFloat(r.Fields[2], "DirectionMagnetic")
+	if err != nil {
+		return err
+	}
+
+	m.Speed, err = parseFieldSpeed(r.Fields[4:8], "Speed")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseFieldSpeed will parse the first available value from pairs of speed and unit fields, returning it in knots
+func parseFieldSpeed(fields []string, name string) (float64, error) {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i] == "" {
+			continue
+		}
+		unit, err := parseSpeedUnit(fields[i+1], name)
+		if err != nil {
+			return 0, err
+		}
+		val, err := parseFieldFloat(fields[i], name)
+		if err != nil {
+			return 0, err
+		}
+		return ConvertSpeed(val, unit, SpeedUnitKnots), nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,39 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mwdStr = "$WIMWD,10.1,T,,M,,N,6.2,M*6E"
+
+func TestMWD_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(mwdStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := new(MWD)
+	err = m.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeMWD, m.Type(), "type")
+	assert.Equal(t, 10.1, m.DirectionTrue, "direction true")
+	assert.True(t, math.IsNaN(m.DirectionMagnetic), "direction magnetic")
+	assert.InDelta(t, 12.051836, m.Speed, epsilon, "speed")
+}
+
+func TestMWD_String(t *testing.T) {
+	str := MWD{
+		DirectionTrue:     270,
+		DirectionMagnetic: 265.5,
+		Speed:             10,
+	}.String()
+
+	assert.Equal(t, "$WIMWD,270,T,265.5,M,10,N,5.14,M*5A", str)
+
+	str = MWD{DirectionTrue: 270, DirectionMagnetic: math.NaN(), Speed: 10}.String()
+	assert.Equal(t, "$WIMWD,270,T,,M,10,N,5.14,M*70", str)
+}
@@ -0,0 +1,106 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MWVReference indicates if an MWV wind angle is relative to the vessel or theoretical (true)
+type MWVReference string
+
+// References for MWV
+const (
+	MWVReferenceRelative    MWVReference = "R" // apparent wind, relative to the bow
+	MWVReferenceTheoretical MWVReference = "T" // calculated true wind, relative to the bow
+)
+
+// MWV reports wind speed and angle relative to the vessel
+type MWV struct {
+	Talker    string       // talker ID, defaults to WI (weather instruments) when serializing
+	Angle     float64      // wind angle in degrees, clockwise from the bow
+	Reference MWVReference // if the angle and speed are relative or theoretical
+	Speed     float64      // wind speed in Unit
+	Unit      SpeedUnit    // unit of Speed
+	Valid     bool         // true if the data is reported as valid
+}
+
+// Type returns TypeMWV to fulfill the Sentence interface
+func (m MWV) Type() Type {
+	return TypeMWV
+}
+
+// Knots will return the wind speed in knots
+func (m MWV) Knots() float64 {
+	return ConvertSpeed(m.Speed, m.Unit, SpeedUnitKnots)
+}
+
+// String will provide a NMEA formatted string
+func (m MWV) String() string {
+	stat := "V"
+	if m.Valid {
+		stat = "A"
+	}
+	return Raw{
+		TypeName: typeName(m.Talker, "WI", TypeMWV),
+		Fields: []string{
+			strconv.FormatFloat(m.Angle, 'f', -1, 64),
+			string(m.Reference),
+			strconv.FormatFloat(m.Speed, 'f', -1, 64),
+			string(m.Unit),
+			stat,
+		},
+	}.String()
+}
+
+// Parse will parse MWV data from a raw sentence struct
+func (m *MWV) Parse(r *Raw) error {
+	var err error
+	m.Talker, err = parseTalker(r, TypeMWV)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	switch r.Fields[4] {
+	case "A":
+		m.Valid = true
+	case "", "V":
+		m.Valid = false
+	default:
+		return fmt.Errorf("invalid status value: %s", r.Fields[4])
+	}
+
+	m.Angle, err = parseFieldFloat(r.Fields[0], "Angle")
+	if err != nil {
+		return err
+	}
+
+	// instruments with no data send empty fields with a V status
+	switch MWVReference(r.Fields[1]) {
+	case MWVReferenceRelative, MWVReferenceTheoretical:
+		m.Reference = MWVReference(r.Fields[1])
+	case "":
+		if m.Valid {
+			return fmt.Errorf("missing reference")
+		}
+		m.Reference = ""
+	default:
+		return fmt.Errorf("invalid reference: %s", r.Fields[1])
+	}
+
+	m.Speed, err = parseFieldFloat(r.Fields[2], "Speed")
+	if err != nil {
+		return err
+	}
+	m.Unit = ""
+	if r.Fields[3] != "" || m.Valid {
+		m.Unit, err = parseSpeedUnit(r.Fields[3], "Speed")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
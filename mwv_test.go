@@ -0,0 +1,49 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mwvStr = "$WIMWV,214.8,R,0.1,K,A*28"
+
+func TestMWV_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(mwvStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := new(MWV)
+	err = m.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeMWV, m.Type(), "type")
+	assert.Equal(t, "WI", m.Talker, "talker")
+	assert.Equal(t, 214.8, m.Angle, "angle")
+	assert.Equal(t, MWVReferenceRelative, m.Reference, "reference")
+	assert.Equal(t, 0.1, m.Speed, "speed")
+	assert.Equal(t, SpeedUnitKilometersPerHour, m.Unit, "unit")
+	assert.InDelta(t, 0.053996, m.Knots(), epsilon, "knots")
+	assert.True(t, m.Valid, "valid")
+}
+
+func TestMWV_ParseNoData(t *testing.T) {
+	s, err := Parse([]byte("$WIMWV,,,,,V*28"))
+	assert.Nil(t, err)
+	assert.Equal(t, &MWV{Talker: "WI"}, s)
+
+	_, err = Parse([]byte("$WIMWV,,,,,A*3F"))
+	assert.NotNil(t, err)
+}
+
+func TestMWV_String(t *testing.T) {
+	str := MWV{
+		Angle:     45,
+		Reference: MWVReferenceTheoretical,
+		Speed:     12.5,
+		Unit:      SpeedUnitKnots,
+	}.String()
+
+	assert.Equal(t, "$WIMWV,45,T,12.5,N,V*2B", str)
+}
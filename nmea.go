@@ -22,26 +22,85 @@ const (
 	TypeGPGGA Type = "GPGGA"
 )
 
+// Supported NMEA sentence types that may be sent by any talker. The talker ID
+// (e.g. HE for a gyro compass) is available from the Talker field of each sentence.
+const (
+	TypeHDT Type = "HDT"
+	TypeHDG Type = "HDG"
+	TypeHDM Type = "HDM"
+	TypeTHS Type = "THS"
+	TypeMWV Type = "MWV"
+	TypeMWD Type = "MWD"
+	TypeVWR Type = "VWR"
+	TypeVTG Type = "VTG"
+	TypeDBT Type = "DBT"
+	TypeDPT Type = "DPT"
+	TypeDBS Type = "DBS"
+	TypeDBK Type = "DBK"
+	TypeMTW Type = "MTW"
+	TypeVHW Type = "VHW"
+	TypeRMB Type = "RMB"
+	TypeAPB Type = "APB"
+	TypeXTE Type = "XTE"
+	TypeBOD Type = "BOD"
+	TypeBWC Type = "BWC"
+	TypeBWR Type = "BWR"
+	TypeWPL Type = "WPL"
+	TypeRTE Type = "RTE"
+	TypeXDR Type = "XDR"
+	TypeROT Type = "ROT"
+	TypeRSA Type = "RSA"
+	TypeRPM Type = "RPM"
+	TypeTTM Type = "TTM"
+	TypeTLL Type = "TLL"
+	TypeOSD Type = "OSD"
+	TypeRSD Type = "RSD"
+	TypeALR Type = "ALR"
+	TypeALF Type = "ALF"
+	TypeACK Type = "ACK"
+	TypeACN Type = "ACN"
+	TypeTXT Type = "TXT"
+	TypeGSV Type = "GSV"
+)
+
+// MaxSentenceLength is the maximum length of a sentence, including the leading '$' and trailing <CR><LF>
+const MaxSentenceLength = 82
+
 // Sentence is a NMEA sentence
 type Sentence interface {
 	Type() Type
 	String() string
 }
 
-// Raw is a NMEA sentence that has been broken up into its TypeName and Fields. Checksums are handled automatically
+// Raw is a NMEA sentence that has been broken up into its TypeName and Fields. Checksums are handled automatically,
+// as are ^XX hex escapes for reserved characters within Fields.
 type Raw struct {
 	TypeName string
 	Fields   []string
+	Tag      *TagBlock // optional NMEA 4.x TAG block preceding the sentence
+	Start    byte      // start character, '!' for encapsulation sentences (e.g. AIVDM); defaults to '$'
+}
+
+// Type will return the TypeName of the sentence, allowing sentences of unknown type to be handled as a Sentence
+func (r Raw) Type() Type {
+	return Type(r.TypeName)
 }
 
 func (r Raw) String() string {
 	data := r.TypeName
-	if r.Fields != nil && len(r.Fields) > 0 {
-		data += "," + strings.Join(r.Fields, ",")
+	for _, f := range r.Fields {
+		data += "," + encodeEscapes(f)
 	}
 	check := Checksum([]byte(data))
 
-	return fmt.Sprintf("$%s*%02X", data, check)
+	start := r.Start
+	if start == 0 {
+		start = '$'
+	}
+	if r.Tag != nil {
+		return fmt.Sprintf("%s%c%s*%02X", r.Tag.String(), start, data, check)
+	}
+	return fmt.Sprintf("%c%s*%02X", start, data, check)
 }
 
 // Checksum will calculate a NMEA checksum of data
@@ -53,14 +112,35 @@ func Checksum(p []byte) byte {
 	return sum
 }
 
-// ParseRaw will return a Raw struct, validating checksum (if any) and separating individual fields and the type
+// ParseRaw will return a Raw struct, validating checksum (if any) and separating individual fields and the type.
+// Both '$' sentences and '!' encapsulation sentences are accepted.
+// If the sentence is preceded by a TAG block, it is parsed and validated as well.
 func ParseRaw(line []byte) (*Raw, error) {
 	line = bytes.TrimSpace(line)
 	if len(line) == 0 {
 		return nil, io.ErrShortBuffer
 	}
-	if line[0] != '$' {
-		return nil, fmt.Errorf("expected '$' but got '%s'", string(line[0]))
+
+	var tag *TagBlock
+	if line[0] == '\\' {
+		i := bytes.IndexByte(line[1:], '\\')
+		if i == -1 {
+			return nil, errors.New("unterminated TAG block")
+		}
+		var err error
+		tag, err = ParseTagBlock(string(line[:i+2]))
+		if err != nil {
+			return nil, err
+		}
+		line = line[i+2:]
+		if len(line) == 0 {
+			return nil, io.ErrShortBuffer
+		}
+	}
+
+	start := line[0]
+	if start != '$' && start != '!' {
+		return nil, fmt.Errorf("expected '$' or '!' but got '%s'", string(start))
 	}
 	line = line[1:]
 	if len(line) >= 3 && line[len(line)-3] == '*' {
@@ -85,7 +165,14 @@ func ParseRaw(line []byte) (*Raw, error) {
 		fields = append(fields, string(line[:i]))
 		line = line[i+1:]
 	}
-	return &Raw{TypeName: fields[0], Fields: fields[1:]}, nil
+	for i := range fields[1:] {
+		f, err := decodeEscapes(fields[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %s", i, err)
+		}
+		fields[i+1] = f
+	}
+	return &Raw{TypeName: fields[0], Fields: fields[1:], Tag: tag, Start: start}, nil
 }
 
 // Parse will return a struct for the line type. If type is unknown, ErrUnknownType will be returned.
@@ -94,6 +181,10 @@ func Parse(line []byte) (Sentence, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parse(r)
+}
+
+func parse(r *Raw) (Sentence, error) {
 	switch Type(r.TypeName) {
 	case TypeGPRMC:
 		s := new(GPRMC)
@@ -104,7 +195,173 @@ func Parse(line []byte) (Sentence, error) {
 	case TypeGPGGA:
 		s := new(GPGGA)
 		return s, s.Parse(r)
+	}
+
+	if len(r.TypeName) != 5 {
+		return nil, ErrUnknownType
+	}
+	switch Type(r.TypeName[2:]) {
+	case TypeHDT:
+		s := new(HDT)
+		return s, s.Parse(r)
+	case TypeHDG:
+		s := new(HDG)
+		return s, s.Parse(r)
+	case TypeHDM:
+		s := new(HDM)
+		return s, s.Parse(r)
+	case TypeTHS:
+		s := new(THS)
+		return s, s.Parse(r)
+	case TypeMWV:
+		s := new(MWV)
+		return s, s.Parse(r)
+	case TypeMWD:
+		s := new(MWD)
+		return s, s.Parse(r)
+	case TypeVWR:
+		s := new(VWR)
+		return s, s.Parse(r)
+	case TypeVTG:
+		s := new(VTG)
+		return s, s.Parse(r)
+	case TypeDBT:
+		s := new(DBT)
+		return s, s.Parse(r)
+	case TypeDPT:
+		s := new(DPT)
+		return s, s.Parse(r)
+	case TypeDBS:
+		s := new(DBS)
+		return s, s.Parse(r)
+	case TypeDBK:
+		s := new(DBK)
+		return s, s.Parse(r)
+	case TypeMTW:
+		s := new(MTW)
+		return s, s.Parse(r)
+	case TypeVHW:
+		s := new(VHW)
+		return s, s.Parse(r)
+	case TypeRMB:
+		s := new(RMB)
+		return s, s.Parse(r)
+	case TypeAPB:
+		s := new(APB)
+		return s, s.Parse(r)
+	case TypeXTE:
+		s := new(XTE)
+		return s, s.Parse(r)
+	case TypeBOD:
+		s := new(BOD)
+		return s, s.Parse(r)
+	case TypeBWC:
+		s := new(BWC)
+		return s, s.Parse(r)
+	case TypeBWR:
+		s := new(BWR)
+		return s, s.Parse(r)
+	case TypeWPL:
+		s := new(WPL)
+		return s, s.Parse(r)
+	case TypeRTE:
+		s := new(RTE)
+		return s, s.Parse(r)
+	case TypeXDR:
+		s := new(XDR)
+		return s, s.Parse(r)
+	case TypeROT:
+		s := new(ROT)
+		return s, s.Parse(r)
+	case TypeRSA:
+		s := new(RSA)
+		return s, s.Parse(r)
+	case TypeRPM:
+		s := new(RPM)
+		return s, s.Parse(r)
+	case TypeTTM:
+		s := new(TTM)
+		return s, s.Parse(r)
+	case TypeTLL:
+		s := new(TLL)
+		return s, s.Parse(r)
+	case TypeOSD:
+		s := new(OSD)
+		return s, s.Parse(r)
+	case TypeRSD:
+		s := new(RSD)
+		return s, s.Parse(r)
+	case TypeALR:
+		s := new(ALR)
+		return s, s.Parse(r)
+	case TypeALF:
+		s := new(ALF)
+		return s, s.Parse(r)
+	case TypeACK:
+		s := new(ACK)
+		return s, s.Parse(r)
+	case TypeACN:
+		s := new(ACN)
+		return s, s.Parse(r)
+	case TypeTXT:
+		s := new(TXT)
+		return s, s.Parse(r)
+	case TypeGSV:
+		s := new(GSV)
+		return s, s.Parse(r)
 	default:
 		return nil, ErrUnknownType
 	}
 }
+
+// parseRawSentence is like parse, but returns r itself for sentences of an unknown type
+func parseRawSentence(r *Raw) (Sentence, error) {
+	s, err := parse(r)
+	if err == ErrUnknownType {
+		return r, nil
+	}
+	return s, err
+}
+
+// reservedChars are the characters that must be escaped within a field
+const reservedChars = "\r\n$*,!\\^~"
+
+// decodeEscapes will decode ^XX hex escapes in a field
+func decodeEscapes(s string) (string, error) {
+	if strings.IndexByte(s, '^') == -1 {
+		return s, nil
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '^' {
+			buf.WriteByte(s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("incomplete escape sequence at %d", i)
+		}
+		b, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence at %d: %s", i, err)
+		}
+		buf.Write(b)
+		i += 2
+	}
+	return buf.String(), nil
+}
+
+// encodeEscapes will escape reserved characters in a field as ^XX
+func encodeEscapes(s string) string {
+	if !strings.ContainsAny(s, reservedChars) {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(reservedChars, s[i]) == -1 {
+			buf.WriteByte(s[i])
+			continue
+		}
+		fmt.Fprintf(&buf, "^%02X", s[i])
+	}
+	return buf.String()
+}
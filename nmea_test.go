@@ -42,3 +42,61 @@ func ExampleParse_string() {
 func TestChecksum(t *testing.T) {
 	assert.Equal(t, byte(0x16), Checksum([]byte("test")))
 }
+
+func TestParse_talker(t *testing.T) {
+	res, err := Parse([]byte("$GPHDT,274.07,T*03"))
+	assert.Nil(t, err)
+	assert.Equal(t, TypeHDT, res.Type())
+	assert.Equal(t, "GP", res.(*HDT).Talker)
+
+	_, err = Parse([]byte("$GPZZZ,1*50"))
+	assert.Equal(t, ErrUnknownType, err)
+}
+
+func TestDecodeEscapes(t *testing.T) {
+	s, err := decodeEscapes("^24GP^5E^7E")
+	assert.Nil(t, err)
+	assert.Equal(t, "$GP^~", s)
+
+	_, err = decodeEscapes("bad^2")
+	assert.NotNil(t, err)
+	_, err = decodeEscapes("bad^ZZ")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "^24GP^5E^7E", encodeEscapes("$GP^~"))
+}
+
+func TestRaw_escapes(t *testing.T) {
+	r := &Raw{TypeName: "GPTXT", Fields: []string{"01", "01", "01", "A,B*C"}}
+	str := r.String()
+	assert.Equal(t, "$GPTXT,01,01,01,A^2CB^2AC*0C", str)
+
+	res, err := ParseRaw([]byte(str))
+	assert.Nil(t, err)
+	assert.EqualValues(t, r.Fields, res.Fields)
+
+	_, err = ParseRaw([]byte("$GPTXT,01,01,01,A^ZZ"))
+	assert.NotNil(t, err)
+}
+
+func TestParseRaw_encapsulation(t *testing.T) {
+	const line = `\s:r003669959,c:1241544035*74\!AIVDM,1,1,,A,13u?etPv2;0n:dDPwUM1U1Cb069D,0*24`
+	r, err := ParseRaw([]byte(line))
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, "AIVDM", r.TypeName)
+	assert.Equal(t, byte('!'), r.Start)
+	assert.Equal(t, "13u?etPv2;0n:dDPwUM1U1Cb069D", r.Fields[4])
+	if assert.NotNil(t, r.Tag) {
+		assert.Equal(t, "r003669959", r.Tag.Source)
+	}
+	assert.Equal(t, line, r.String())
+
+	r, err = ParseRaw([]byte(line[len(`\s:r003669959,c:1241544035*74\`):]))
+	assert.Nil(t, err)
+	assert.Equal(t, "!AIVDM,1,1,,A,13u?etPv2;0n:dDPwUM1U1Cb069D,0*24", r.String())
+
+	_, err = ParseRaw([]byte("#AIVDM,1,1,,A,13u?etPv2;0n:dDPwUM1U1Cb069D,0"))
+	assert.NotNil(t, err)
+}
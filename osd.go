@@ -0,0 +1,104 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// OSDReference is the reference used for own ship course and speed in an OSD sentence
+type OSDReference string
+
+// References for OSD
+const (
+	OSDReferenceBottom      OSDReference = "B" // bottom tracking log
+	OSDReferenceManual      OSDReference = "M"
+	OSDReferenceWater       OSDReference = "W" // water referenced
+	OSDReferenceRadar       OSDReference = "R" // radar tracking of fixed targets
+	OSDReferencePositioning OSDReference = "P" // positioning system ground reference
+)
+
+// OSD reports own ship data as used by a radar
+type OSD struct {
+	Talker          string       `csv:"talker"`           // talker ID, defaults to RA (radar) when serializing
+	Heading         float64      `csv:"heading_true_deg"` // heading in degrees True
+	Valid           bool         `csv:"valid"`            // true if Heading is reported as valid
+	Course          float64      `csv:"course_deg"`       // course in degrees True
+	CourseReference OSDReference `csv:"course_reference"` // reference for Course
+	Speed           float64      `csv:"speed_kn"`         // speed in knots
+	SpeedReference  OSDReference `csv:"speed_reference"`  // reference for Speed
+	Set             float64      `csv:"set_true_deg"`     // vessel set in degrees True
+	Drift           float64      `csv:"drift_kn"`         // vessel drift in knots
+}
+
+// Type returns TypeOSD to fulfill the Sentence interface
+func (o OSD) Type() Type {
+	return TypeOSD
+}
+
+// String will provide a NMEA formatted string. Speeds are written in knots
+func (o OSD) String() string {
+	return Raw{
+		TypeName: typeName(o.Talker, "RA", TypeOSD),
+		Fields: []string{
+			strconv.FormatFloat(o.Heading, 'f', -1, 64),
+			formatFieldStatus(o.Valid),
+			strconv.FormatFloat(o.Course, 'f', -1, 64),
+			string(o.CourseReference),
+			strconv.FormatFloat(o.Speed, 'f', -1, 64),
+			string(o.SpeedReference),
+			strconv.FormatFloat(o.Set, 'f', -1, 64),
+			strconv.FormatFloat(o.Drift, 'f', -1, 64),
+			string(SpeedUnitKnots),
+		},
+	}.String()
+}
+
+// Parse will parse OSD data from a raw sentence struct. Speeds are converted to knots
+func (o *OSD) Parse(r *Raw) error {
+	var err error
+	o.Talker, err = parseTalker(r, TypeOSD)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 9 {
+		return fmt.Errorf("not enough fields, need at least 9")
+	}
+
+	o.Heading, err = parseFieldFloat(r.Fields[0], "Heading")
+	if err != nil {
+		return err
+	}
+	o.Valid, err = parseFieldStatus(r.Fields[1], "heading status")
+	if err != nil {
+		return err
+	}
+
+	unit, err := parseDistanceUnit(r.Fields[8], "Speed")
+	if err != nil {
+		return err
+	}
+
+	o.Course, err = parseFieldFloat(r.Fields[2], "Course")
+	if err != nil {
+		return err
+	}
+	o.CourseReference = OSDReference(r.Fields[3])
+	o.Speed, err = parseFieldFloat(r.Fields[4], "Speed")
+	if err != nil {
+		return err
+	}
+	o.Speed = ConvertSpeed(o.Speed, unit, SpeedUnitKnots)
+	o.SpeedReference = OSDReference(r.Fields[5])
+
+	o.Set, err = parseFieldFloat(r.Fields[6], "Set")
+	if err != nil {
+		return err
+	}
+	o.Drift, err = parseFieldFloat(r.Fields[7], "Drift")
+	if err != nil {
+		return err
+	}
+	o.Drift = ConvertSpeed(o.Drift, unit, SpeedUnitKnots)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const osdStr = "$RAOSD,90,A,91,B,18.52,W,0,0,K*59"
+
+func TestOSD_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(osdStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := new(OSD)
+	err = o.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeOSD, o.Type(), "type")
+	assert.Equal(t, 90.0, o.Heading, "heading")
+	assert.True(t, o.Valid, "valid")
+	assert.Equal(t, 91.0, o.Course, "course")
+	assert.Equal(t, OSDReferenceBottom, o.CourseReference, "course reference")
+	assert.InDelta(t, 10, o.Speed, epsilon, "speed")
+	assert.Equal(t, OSDReferenceWater, o.SpeedReference, "speed reference")
+	assert.Zero(t, o.Set, "set")
+	assert.Zero(t, o.Drift, "drift")
+}
+
+func TestOSD_String(t *testing.T) {
+	str := OSD{
+		Heading:         35.1,
+		Valid:           true,
+		Course:          36,
+		CourseReference: OSDReferencePositioning,
+		Speed:           10.2,
+		SpeedReference:  OSDReferencePositioning,
+		Set:             15.3,
+		Drift:           0.1,
+	}.String()
+
+	assert.Equal(t, "$RAOSD,35.1,A,36,P,10.2,P,15.3,0.1,N*5F", str)
+}
@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -27,6 +28,23 @@ func parseFieldFloat(val, name string) (float64, error) {
 	return f, nil
 }
 
+// parseFieldOptFloat is like parseFieldFloat, but returns NaN for an empty field so that a missing
+// value can be distinguished from zero
+func parseFieldOptFloat(val, name string) (float64, error) {
+	if val == "" {
+		return math.NaN(), nil
+	}
+	return parseFieldFloat(val, name)
+}
+
+// formatFieldOptFloat will format f, or an empty field if f is NaN
+func formatFieldOptFloat(f float64) string {
+	if math.IsNaN(f) {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
 func parseFieldCoord(val, dirStr, typeName string) (Coord, error) {
 	var dir CoordDirection
 	if val == "" && dirStr != "" {
@@ -61,3 +79,104 @@ func parseFieldCoord(val, dirStr, typeName string) (Coord, error) {
 	}
 	return c, nil
 }
+
+// parseTalker will validate that r is of type t, sent by any talker, and return the talker ID
+func parseTalker(r *Raw, t Type) (string, error) {
+	if len(r.TypeName) != len(t)+2 || r.TypeName[2:] != string(t) {
+		return "", fmt.Errorf("wrong type for %s '%s'", t, r.TypeName)
+	}
+	return r.TypeName[:2], nil
+}
+
+// typeName will return the full type name for t sent by talker, using def if talker is empty
+func typeName(talker, def string, t Type) string {
+	if talker == "" {
+		talker = def
+	}
+	return talker + string(t)
+}
+
+// parseFieldDegreesEW will parse an angle in decimal degrees with an E/W direction, such as magnetic variation
+func parseFieldDegreesEW(val, dirStr, name string) (Coord, error) {
+	if val == "" {
+		return 0, nil
+	}
+	deg, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %s", name, err)
+	}
+	switch dirStr {
+	case "E":
+		return CoordFromDD(deg, CoordDirectionEast), nil
+	case "W":
+		return CoordFromDD(deg, CoordDirectionWest), nil
+	default:
+		return 0, fmt.Errorf("invalid or missing direction for %s", name)
+	}
+}
+
+// formatFieldDegreesEW will format an angle as decimal degrees and an E/W direction
+func formatFieldDegreesEW(c Coord) (val, dir string) {
+	deg, d := c.DD()
+	return strconv.FormatFloat(deg, 'f', -1, 64), d.LongString()
+}
+
+// parseFieldStatus will parse an A (valid/active) or V (void) status field. An empty field is considered void
+func parseFieldStatus(val, name string) (bool, error) {
+	switch val {
+	case "A":
+		return true, nil
+	case "", "V":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid %s value: %s", name, val)
+	}
+}
+
+// formatFieldStatus will format a status as A (valid/active) or V (void)
+func formatFieldStatus(ok bool) string {
+	if ok {
+		return "A"
+	}
+	return "V"
+}
+
+// parseFieldSteer will parse a cross-track error and L/R direction to steer into a signed value, positive to steer right
+func parseFieldSteer(val, dirStr, name string) (float64, error) {
+	v, err := parseFieldFloat(val, name)
+	if err != nil {
+		return 0, err
+	}
+	switch dirStr {
+	case "R", "":
+		return v, nil
+	case "L":
+		return -v, nil
+	default:
+		return 0, fmt.Errorf("invalid direction to steer for %s: %s", name, dirStr)
+	}
+}
+
+// formatFieldSteer will format a signed cross-track error as a magnitude and L/R direction to steer
+func formatFieldSteer(v float64) (val, dir string) {
+	dir = "R"
+	if v < 0 {
+		v, dir = -v, "L"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), dir
+}
+
+// parseFieldFixType will parse an optional mode indicator field, added in NMEA version 2.3, at index i
+func parseFieldFixType(fields []string, i int) (GPRMCFix, error) {
+	if len(fields) <= i || fields[i] == "" {
+		return GPRMCFixUnspecified, nil
+	}
+	switch GPRMCFix(fields[i]) {
+	case GPRMCFixAutonomous, GPRMCFixDifferential, GPRMCFixEstimated,
+		GPRMCFixNotValid, GPRMCFixSimulator:
+
+		return GPRMCFix(fields[i]), nil
+	default:
+		return "", fmt.Errorf("unknown fix type value: %s", fields[i])
+	}
+}
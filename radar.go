@@ -0,0 +1,153 @@
+package nmea
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// RadarTarget is a target tracked by radar, with its position resolved from own ship data
+type RadarTarget struct {
+	Number      int
+	Name        string
+	Latitude    Coord
+	Longitude   Coord
+	HasPosition bool          // false if no own ship position was known when the target was reported by TTM
+	Speed       float64       // speed in knots
+	Course      float64       // course in degrees True
+	CPA         float64       // distance at the closest point of approach in nautical miles, as reported by the radar
+	TCPA        time.Duration // time to the closest point of approach, as reported by the radar
+	Status      TTMStatus
+	Reference   bool // true if this is a reference target
+}
+
+// Target will return the radar target as a CPA Target, identified by its number
+func (t RadarTarget) Target() Target {
+	return Target{
+		ID: strconv.Itoa(t.Number),
+		Vessel: Vessel{
+			Latitude:  t.Latitude,
+			Longitude: t.Longitude,
+			Speed:     t.Speed,
+			Course:    t.Course,
+		},
+	}
+}
+
+// RadarTracker merges radar targets from TTM and TLL sentences with own ship position, so
+// they can be displayed alongside GPS data. Own ship data is taken from GPRMC, and heading
+// from HDT, THS or OSD; if no heading is known the course over ground is used instead.
+type RadarTracker struct {
+	own        Vessel
+	hasOwn     bool
+	heading    float64
+	hasHeading bool
+
+	targets map[int]*RadarTarget
+}
+
+// Own will return the current own ship data
+func (t *RadarTracker) Own() Vessel {
+	return t.own
+}
+
+// Update will update own ship data or targets from the sentence. Unrelated sentences are ignored.
+func (t *RadarTracker) Update(s Sentence) {
+	if t.targets == nil {
+		t.targets = make(map[int]*RadarTarget)
+	}
+
+	switch v := s.(type) {
+	case *GPRMC:
+		if v.Active {
+			t.own, t.hasOwn = VesselFromGPRMC(*v), true
+		}
+	case *HDT:
+		t.heading, t.hasHeading = v.Heading, true
+	case *THS:
+		if v.Mode != THSModeNotValid {
+			t.heading, t.hasHeading = v.Heading, true
+		}
+	case *OSD:
+		if v.Valid {
+			t.heading, t.hasHeading = v.Heading, true
+		}
+	case *TTM:
+		t.updateTTM(v)
+	case *TLL:
+		t.updateTLL(v)
+	}
+}
+
+func (t *RadarTracker) target(num int) *RadarTarget {
+	tgt := t.targets[num]
+	if tgt == nil {
+		tgt = &RadarTarget{Number: num}
+		t.targets[num] = tgt
+	}
+	return tgt
+}
+
+func (t *RadarTracker) updateTTM(m *TTM) {
+	if m.Status == TTMStatusLost {
+		delete(t.targets, m.Number)
+		return
+	}
+
+	heading := t.own.Course
+	if t.hasHeading {
+		heading = t.heading
+	}
+
+	tgt := t.target(m.Number)
+	tgt.Name = m.Name
+	tgt.Status = m.Status
+	tgt.Reference = m.Reference
+	tgt.CPA = m.CPA
+	tgt.TCPA = m.TCPA
+
+	tgt.Speed, tgt.Course = m.Speed, m.Course
+	if m.CourseRelative {
+		// add own ship motion to the relative motion
+		ox, oy := velocity(t.own)
+		rx, ry := velocity(Vessel{Speed: m.Speed, Course: m.Course})
+		tgt.Speed = math.Hypot(ox+rx, oy+ry)
+		tgt.Course = normalizeHeading(math.Atan2(ox+rx, oy+ry) * 180 / math.Pi)
+	}
+
+	if !t.hasOwn {
+		tgt.HasPosition = false
+		return
+	}
+	bearing := m.Bearing
+	if m.BearingRelative {
+		bearing = normalizeHeading(bearing + heading)
+	}
+	tgt.Latitude, tgt.Longitude = Destination(t.own.Latitude, t.own.Longitude, bearing, m.Distance*MetersPerNauticalMile)
+	tgt.HasPosition = true
+}
+
+func (t *RadarTracker) updateTLL(l *TLL) {
+	if l.Status == TTMStatusLost {
+		delete(t.targets, l.Number)
+		return
+	}
+
+	tgt := t.target(l.Number)
+	tgt.Name = l.Name
+	tgt.Status = l.Status
+	tgt.Reference = l.Reference
+	tgt.Latitude, tgt.Longitude = l.Latitude, l.Longitude
+	tgt.HasPosition = true
+}
+
+// Targets will return all currently tracked targets, ordered by number
+func (t *RadarTracker) Targets() []RadarTarget {
+	res := make([]RadarTarget, 0, len(t.targets))
+	for _, tgt := range t.targets {
+		res = append(res, *tgt)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Number < res[j].Number })
+	return res
+}
@@ -0,0 +1,40 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRadarTracker_Update(t *testing.T) {
+	var tr RadarTracker
+
+	// target reported before own position is known
+	tr.Update(&TTM{Number: 1, Distance: 1, Bearing: 90, BearingRelative: true, Speed: 5, Course: 0, Status: TTMStatusTracking})
+	res := tr.Targets()
+	if assert.Len(t, res, 1) {
+		assert.False(t, res[0].HasPosition)
+	}
+
+	tr.Update(&GPRMC{Active: true, Latitude: Coord(10), Longitude: Coord(20), Speed: 10, TrueCourse: 0})
+	tr.Update(&HDT{Heading: 0})
+
+	// 1nm off the starboard beam, closing head-on in relative motion
+	tr.Update(&TTM{Number: 1, Distance: 1, Bearing: 90, BearingRelative: true, Speed: 10, Course: 180, CourseRelative: true, Status: TTMStatusTracking})
+	tr.Update(&TLL{Number: 2, Latitude: Coord(10.5), Longitude: Coord(20.5), Name: "BUOY", Status: TTMStatusTracking})
+
+	res = tr.Targets()
+	if assert.Len(t, res, 2) {
+		assert.True(t, res[0].HasPosition)
+		assert.InDelta(t, 10, float64(res[0].Latitude), 0.0001, "latitude")
+		assert.InDelta(t, 20+1.0/60/0.98481, float64(res[0].Longitude), 0.0001, "longitude")
+		assert.InDelta(t, 0, res[0].Speed, epsilon, "speed")
+
+		assert.Equal(t, "BUOY", res[1].Name)
+		assert.Equal(t, Coord(10.5), res[1].Latitude)
+		assert.Equal(t, "2", res[1].Target().ID)
+	}
+
+	tr.Update(&TTM{Number: 1, Status: TTMStatusLost})
+	assert.Len(t, tr.Targets(), 1)
+}
@@ -0,0 +1,86 @@
+package nmea
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"time"
+)
+
+// Recorder writes received lines to a log along with the time each was received.
+//
+// By default the receive time is stored in the c: parameter of a TAG block preceding each line
+// (with millisecond resolution), replacing any existing c: value. With a sidecar, lines are written
+// unchanged and the receive times are written to the sidecar instead, one RFC 3339 timestamp with
+// nanosecond resolution per line.
+type Recorder struct {
+	w  io.Writer
+	ts io.Writer
+
+	now func() time.Time
+}
+
+// NewRecorder will return a Recorder writing lines with TAG block timestamps to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w, now: time.Now}
+}
+
+// NewSidecarRecorder will return a Recorder writing lines unchanged to w, and their timestamps to timestamps
+func NewSidecarRecorder(w, timestamps io.Writer) *Recorder {
+	return &Recorder{w: w, ts: timestamps, now: time.Now}
+}
+
+// RecordLine will write a line received at t. The line is not required to be a valid sentence.
+func (r *Recorder) RecordLine(line []byte, t time.Time) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	if r.ts != nil {
+		_, err := io.WriteString(r.ts, t.UTC().Format(time.RFC3339Nano)+"\n")
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(r.w, string(line)+"\r\n")
+		return err
+	}
+
+	tag := new(TagBlock)
+	if line[0] == '\\' {
+		if i := bytes.IndexByte(line[1:], '\\'); i != -1 {
+			if existing, err := ParseTagBlock(string(line[:i+2])); err == nil {
+				tag = existing
+				line = line[i+2:]
+			}
+		}
+	}
+	tag.Time = t.UTC().Truncate(time.Millisecond)
+	_, err := io.WriteString(r.w, tag.String()+string(line)+"\r\n")
+	return err
+}
+
+// WriteSentence will record the sentence as received now
+func (r *Recorder) WriteSentence(s Sentence) error {
+	return r.RecordLine([]byte(s.String()), r.now())
+}
+
+// Copy will record each line read from src as it is received, until src returns an error.
+// If the error is io.EOF, nil is returned.
+func (r *Recorder) Copy(src io.Reader) error {
+	br := bufio.NewReader(src)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if werr := r.RecordLine(line, r.now()); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
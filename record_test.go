@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_RecordLine(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewRecorder(&buf)
+	ts := time.Date(2020, 9, 13, 12, 26, 40, 123456789, time.UTC)
+
+	assert.Nil(t, r.RecordLine([]byte(gprmcStr+"\r\n"), ts))
+	assert.Nil(t, r.RecordLine([]byte(`\s:GP0001,c:1*1B\`+gpggaStr), ts.Add(time.Second)))
+	assert.Nil(t, r.RecordLine([]byte("garbage"), ts.Add(2*time.Second)))
+	assert.Nil(t, r.RecordLine([]byte("\r\n"), ts))
+
+	lines := strings.Split(buf.String(), "\r\n")
+	if assert.Len(t, lines, 4) {
+		assert.Equal(t, TagBlock{Time: ts.Truncate(time.Millisecond)}.String()+gprmcStr, lines[0])
+		assert.Equal(t, TagBlock{Source: "GP0001", Time: time.Unix(1600000001, 123000000).UTC()}.String()+gpggaStr, lines[1])
+
+		raw, err := ParseRaw([]byte(lines[1]))
+		assert.Nil(t, err)
+		assert.Equal(t, "GP0001", raw.Tag.Source)
+		assert.Equal(t, ts.Add(time.Second).Truncate(time.Millisecond), raw.Tag.Time)
+	}
+}
+
+func TestRecorder_sidecar(t *testing.T) {
+	var buf, ts bytes.Buffer
+	r := NewSidecarRecorder(&buf, &ts)
+	t0 := time.Date(2020, 9, 13, 12, 26, 40, 123456789, time.UTC)
+	r.now = func() time.Time {
+		t0 = t0.Add(time.Second)
+		return t0
+	}
+
+	assert.Nil(t, r.Copy(strings.NewReader(gprmcStr+"\r\n\r\n"+gpggaStr)))
+	assert.Nil(t, r.WriteSentence(HDT{Talker: "HE", Heading: 1}))
+	assert.Equal(t, gprmcStr+"\r\n"+gpggaStr+"\r\n"+HDT{Talker: "HE", Heading: 1}.String()+"\r\n", buf.String())
+	assert.Equal(t, "2020-09-13T12:26:41.123456789Z\n2020-09-13T12:26:42.123456789Z\n2020-09-13T12:26:43.123456789Z\n", ts.String())
+}
@@ -0,0 +1,189 @@
+package nmea
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ErrNotSeekable is returned when seeking backwards in a log that is not an io.Seeker
+var ErrNotSeekable = errors.New("log is not seekable")
+
+// Player reads a log written by a Recorder and re-emits the lines with their original timing
+type Player struct {
+	// Speed scales the playback rate: 1 is the original speed, 2 is twice as fast.
+	// Zero plays at maximum speed, without any delay.
+	Speed float64
+
+	// KeepTags will write lines as recorded, including the TAG block. By default the TAG
+	// block is removed.
+	KeepTags bool
+
+	src, tsSrc io.Reader
+	r, ts      *bufio.Reader
+
+	pending *logLine
+	last    time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+type logLine struct {
+	line string
+	t    time.Time
+}
+
+// NewPlayer will return a Player for a log with TAG block timestamps
+func NewPlayer(r io.Reader) *Player {
+	return NewSidecarPlayer(r, nil)
+}
+
+// NewSidecarPlayer will return a Player for a log with timestamps in a sidecar
+func NewSidecarPlayer(r, timestamps io.Reader) *Player {
+	p := &Player{Speed: 1, src: r, tsSrc: timestamps, now: time.Now, sleep: time.Sleep}
+	p.reset()
+	return p
+}
+
+func (p *Player) reset() {
+	p.r = bufio.NewReader(p.src)
+	if p.tsSrc != nil {
+		p.ts = bufio.NewReader(p.tsSrc)
+	}
+	p.pending = nil
+	p.last = time.Time{}
+}
+
+func (p *Player) read() (*logLine, error) {
+	for {
+		line, err := p.r.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if p.ts != nil {
+			tsLine, tsErr := p.ts.ReadString('\n')
+			tsLine = strings.TrimSpace(tsLine)
+			if tsLine == "" && tsErr != nil {
+				return nil, errors.New("sidecar ended before log")
+			}
+			t, err := time.Parse(time.RFC3339Nano, tsLine)
+			if err != nil {
+				return nil, fmt.Errorf("parse sidecar timestamp: %s", err)
+			}
+			p.last = t
+			return &logLine{line: string(line), t: t}, nil
+		}
+
+		// lines without a timestamp are played along with the previous line
+		if line[0] == '\\' {
+			if i := bytes.IndexByte(line[1:], '\\'); i != -1 {
+				tag, err := ParseTagBlock(string(line[:i+2]))
+				if err != nil {
+					return nil, err
+				}
+				if !tag.Time.IsZero() {
+					p.last = tag.Time
+				}
+				if !p.KeepTags {
+					line = line[i+2:]
+				}
+			}
+		}
+		return &logLine{line: string(line), t: p.last}, nil
+	}
+}
+
+// Next will return the next line in the log along with the time it was received
+func (p *Player) Next() (string, time.Time, error) {
+	l := p.pending
+	p.pending = nil
+	if l == nil {
+		var err error
+		l, err = p.read()
+		if err != nil {
+			return "", time.Time{}, err
+		}
+	}
+	return l.line, l.t, nil
+}
+
+// Seek will position the log at the first line received at or after t. Seeking backwards requires
+// the log (and sidecar) to be an io.Seeker. If there are no lines after t, io.EOF is returned.
+func (p *Player) Seek(t time.Time) error {
+	pos := p.last
+	if p.pending != nil {
+		pos = p.pending.t
+	}
+	if t.Before(pos) {
+		for _, src := range []io.Reader{p.src, p.tsSrc} {
+			if src == nil {
+				continue
+			}
+			s, ok := src.(io.Seeker)
+			if !ok {
+				return ErrNotSeekable
+			}
+			if _, err := s.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		}
+		p.reset()
+	}
+
+	for {
+		l := p.pending
+		if l == nil {
+			var err error
+			l, err = p.read()
+			if err != nil {
+				return err
+			}
+		}
+		if !l.t.Before(t) {
+			p.pending = l
+			return nil
+		}
+		p.pending = nil
+	}
+}
+
+// Play will write each line to w, followed by <CR><LF>, with the original timing scaled by Speed.
+// The first line is written immediately. Play returns when the end of the log is reached.
+func (p *Player) Play(w io.Writer) error {
+	var started bool
+	var start, first time.Time
+	for {
+		line, t, err := p.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if p.Speed > 0 && !t.IsZero() {
+			if !started {
+				started, start, first = true, p.now(), t
+			}
+			at := start.Add(time.Duration(float64(t.Sub(first)) / p.Speed))
+			if d := at.Sub(p.now()); d > 0 {
+				p.sleep(d)
+			}
+		}
+
+		_, err = io.WriteString(w, line+"\r\n")
+		if err != nil {
+			return err
+		}
+	}
+}
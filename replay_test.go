@@ -0,0 +1,107 @@
+package nmea
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLog(t *testing.T) (string, time.Time) {
+	var buf bytes.Buffer
+	r := NewRecorder(&buf)
+	t0 := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	assert.Nil(t, r.RecordLine([]byte(gprmcStr), t0))
+	assert.Nil(t, r.RecordLine([]byte(gpggaStr), t0.Add(500*time.Millisecond)))
+	assert.Nil(t, r.RecordLine([]byte(gpgsaStr), t0.Add(2*time.Second)))
+	return buf.String(), t0
+}
+
+// fakeClock records sleeps, advancing the time
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+}
+
+func TestPlayer_Play(t *testing.T) {
+	log, _ := testLog(t)
+
+	for _, speed := range []float64{1, 2} {
+		var buf bytes.Buffer
+		clock := new(fakeClock)
+		p := NewPlayer(strings.NewReader(log))
+		p.Speed = speed
+		p.now, p.sleep = clock.Now, clock.Sleep
+		assert.Nil(t, p.Play(&buf))
+		assert.Equal(t, gprmcStr+"\r\n"+gpggaStr+"\r\n"+gpgsaStr+"\r\n", buf.String())
+		assert.Equal(t, []time.Duration{
+			time.Duration(float64(500*time.Millisecond) / speed),
+			time.Duration(float64(1500*time.Millisecond) / speed),
+		}, clock.sleeps, "speed %g", speed)
+	}
+
+	// maximum speed
+	var buf bytes.Buffer
+	clock := new(fakeClock)
+	p := NewPlayer(strings.NewReader(log))
+	p.Speed = 0
+	p.KeepTags = true
+	p.now, p.sleep = clock.Now, clock.Sleep
+	assert.Nil(t, p.Play(&buf))
+	assert.Equal(t, log, buf.String())
+	assert.Empty(t, clock.sleeps)
+}
+
+func TestPlayer_Seek(t *testing.T) {
+	log, t0 := testLog(t)
+	p := NewPlayer(struct{ io.Reader }{strings.NewReader(log)})
+
+	assert.Nil(t, p.Seek(t0.Add(100*time.Millisecond)))
+	line, ts, err := p.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, gpggaStr, line)
+	assert.Equal(t, t0.Add(500*time.Millisecond), ts)
+
+	assert.Equal(t, ErrNotSeekable, p.Seek(t0))
+	assert.Equal(t, io.EOF, p.Seek(t0.Add(time.Hour)))
+
+	// seeking backwards requires an io.Seeker
+	p = NewPlayer(bytes.NewReader([]byte(log)))
+	assert.Nil(t, p.Seek(t0.Add(2*time.Second)))
+	assert.Nil(t, p.Seek(t0))
+	line, _, err = p.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, gprmcStr, line)
+}
+
+func TestPlayer_sidecar(t *testing.T) {
+	var buf, ts bytes.Buffer
+	r := NewSidecarRecorder(&buf, &ts)
+	t0 := time.Date(2020, 9, 13, 12, 26, 40, 123456789, time.UTC)
+	assert.Nil(t, r.RecordLine([]byte("garbage"), t0))
+	assert.Nil(t, r.RecordLine([]byte(gprmcStr), t0.Add(time.Microsecond)))
+
+	p := NewSidecarPlayer(bytes.NewReader(buf.Bytes()), bytes.NewReader(ts.Bytes()))
+	assert.Nil(t, p.Seek(t0.Add(time.Nanosecond)))
+	line, lt, err := p.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, gprmcStr, line)
+	assert.Equal(t, t0.Add(time.Microsecond), lt)
+
+	assert.Nil(t, p.Seek(t0))
+	assert.Nil(t, p.Play(io.Discard))
+
+	// sidecar shorter than the log
+	p = NewSidecarPlayer(strings.NewReader(buf.String()+gpggaStr), bytes.NewReader(ts.Bytes()))
+	p.Speed = 0
+	assert.NotNil(t, p.Play(io.Discard))
+}
@@ -0,0 +1,110 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RMB reports navigation data towards a destination waypoint
+type RMB struct {
+	Talker      string   `csv:"talker"`           // talker ID, defaults to GP when serializing
+	Valid       bool     `csv:"valid"`            // true if the data is reported as valid
+	CrossTrack  float64  `csv:"cross_track_nm"`   // cross-track error in nautical miles. Positive to steer right, negative to steer left
+	Origin      string   `csv:"origin"`           // origin waypoint ID
+	Destination string   `csv:"destination"`      // destination waypoint ID
+	Latitude    Coord    `csv:"latitude_deg"`     // destination waypoint latitude
+	Longitude   Coord    `csv:"longitude_deg"`    // destination waypoint longitude
+	Range       float64  `csv:"range_nm"`         // range to destination in nautical miles
+	Bearing     float64  `csv:"bearing_true_deg"` // bearing to destination in degrees True
+	Velocity    float64  `csv:"velocity_kn"`      // closing velocity towards destination in knots
+	Arrived     bool     `csv:"arrived"`          // true if the arrival circle has been entered or the perpendicular passed
+	FixType     GPRMCFix `csv:"fix_type"`
+}
+
+// Type returns TypeRMB to fulfill the Sentence interface
+func (b RMB) Type() Type {
+	return TypeRMB
+}
+
+// String will provide a NMEA formatted string
+func (b RMB) String() string {
+	xte, steer := formatFieldSteer(b.CrossTrack)
+	return Raw{
+		TypeName: typeName(b.Talker, "GP", TypeRMB),
+		Fields: []string{
+			formatFieldStatus(b.Valid),
+			xte,
+			steer,
+			b.Origin,
+			b.Destination,
+			b.Latitude.String(),
+			b.Latitude.Direction().LatString(),
+			b.Longitude.LonString(),
+			b.Longitude.Direction().LongString(),
+			strconv.FormatFloat(b.Range, 'f', -1, 64),
+			strconv.FormatFloat(b.Bearing, 'f', -1, 64),
+			strconv.FormatFloat(b.Velocity, 'f', -1, 64),
+			formatFieldStatus(b.Arrived),
+			string(b.FixType),
+		},
+	}.String()
+}
+
+// Parse will parse RMB data from a raw sentence struct
+func (b *RMB) Parse(r *Raw) error {
+	var err error
+	b.Talker, err = parseTalker(r, TypeRMB)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 13 {
+		return fmt.Errorf("not enough fields, need at least 13")
+	}
+
+	b.Valid, err = parseFieldStatus(r.Fields[0], "status")
+	if err != nil {
+		return err
+	}
+
+	b.CrossTrack, err = parseFieldSteer(r.Fields[1], r.Fields[2], "CrossTrack")
+	if err != nil {
+		return err
+	}
+
+	b.Origin = r.Fields[3]
+	b.Destination = r.Fields[4]
+
+	b.Latitude, err = parseFieldCoord(r.Fields[5], r.Fields[6], "latitude")
+	if err != nil {
+		return err
+	}
+	b.Longitude, err = parseFieldCoord(r.Fields[7], r.Fields[8], "longitude")
+	if err != nil {
+		return err
+	}
+
+	b.Range, err = parseFieldFloat(r.Fields[9], "Range")
+	if err != nil {
+		return err
+	}
+	b.Bearing, err = parseFieldFloat(r.Fields[10], "Bearing")
+	if err != nil {
+		return err
+	}
+	b.Velocity, err = parseFieldFloat(r.Fields[11], "Velocity")
+	if err != nil {
+		return err
+	}
+
+	b.Arrived, err = parseFieldStatus(r.Fields[12], "arrival status")
+	if err != nil {
+		return err
+	}
+
+	b.FixType, err = parseFieldFixType(r.Fields, 13)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
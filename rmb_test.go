@@ -0,0 +1,49 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rmbStr = "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20"
+
+func TestRMB_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rmbStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(RMB)
+	err = b.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeRMB, b.Type(), "type")
+	assert.True(t, b.Valid, "valid")
+	assert.Equal(t, -0.66, b.CrossTrack, "cross track")
+	assert.Equal(t, "003", b.Origin, "origin")
+	assert.Equal(t, "004", b.Destination, "destination")
+	assert.InDelta(t, 49.287333, float64(b.Latitude), epsilon, "latitude")
+	assert.Equal(t, 1.3, b.Range, "range")
+	assert.Equal(t, 52.5, b.Bearing, "bearing")
+	assert.Equal(t, 0.5, b.Velocity, "velocity")
+	assert.False(t, b.Arrived, "arrived")
+	assert.Equal(t, GPRMCFixUnspecified, b.FixType)
+}
+
+func TestRMB_String(t *testing.T) {
+	str := RMB{
+		Valid:       true,
+		CrossTrack:  0.5,
+		Origin:      "A",
+		Destination: "B",
+		Latitude:    Coord(45.51),
+		Longitude:   Coord(-45.26),
+		Range:       12.3,
+		Bearing:     270,
+		Velocity:    5.5,
+		FixType:     GPRMCFixAutonomous,
+	}.String()
+
+	assert.Equal(t, "$GPRMB,A,0.5,R,A,B,4530.6,N,04515.6,W,12.3,270,5.5,V,A*4D", str)
+}
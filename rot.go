@@ -0,0 +1,55 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// ROT reports the rate of turn of the vessel
+type ROT struct {
+	Talker string  `csv:"talker"`       // talker ID, defaults to HE (gyro, north seeking) when serializing
+	Rate   float64 `csv:"rate_deg_min"` // rate of turn in degrees per minute, negative when the bow turns to port. NaN if missing or invalid
+}
+
+// Type returns TypeROT to fulfill the Sentence interface
+func (o ROT) Type() Type {
+	return TypeROT
+}
+
+// String will provide a NMEA formatted string. A NaN Rate is written as missing and invalid
+func (o ROT) String() string {
+	return Raw{
+		TypeName: typeName(o.Talker, "HE", TypeROT),
+		Fields: []string{
+			formatFieldOptFloat(o.Rate),
+			formatFieldStatus(!math.IsNaN(o.Rate)),
+		},
+	}.String()
+}
+
+// Parse will parse ROT data from a raw sentence struct. If the status is not valid, Rate is set to NaN
+func (o *ROT) Parse(r *Raw) error {
+	var err error
+	o.Talker, err = parseTalker(r, TypeROT)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	o.Rate, err = parseFieldOptFloat(r.Fields[0], "Rate")
+	if err != nil {
+		return err
+	}
+
+	valid, err := parseFieldStatus(r.Fields[1], "status")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		o.Rate = math.NaN()
+	}
+
+	return nil
+}
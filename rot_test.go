@@ -0,0 +1,37 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rotStr = "$HEROT,-11.3,A*35"
+
+func TestROT_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rotStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := new(ROT)
+	err = o.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeROT, o.Type(), "type")
+	assert.Equal(t, -11.3, o.Rate, "rate")
+
+	r, err = ParseRaw([]byte("$HEROT,2.5,V*3B"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = o.Parse(r)
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(o.Rate), "invalid rate")
+}
+
+func TestROT_String(t *testing.T) {
+	assert.Equal(t, "$HEROT,2.5,A*2C", ROT{Rate: 2.5}.String())
+	assert.Equal(t, "$HEROT,,V*12", ROT{Rate: math.NaN()}.String())
+}
@@ -0,0 +1,121 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Route is a complete route assembled from, or to be split into, RTE and WPL sentences
+type Route struct {
+	ID        string
+	Complete  bool  // true if this is a complete route, false for the working route
+	Waypoints []WPL // waypoints in order. Waypoints without a known location have only Name set
+}
+
+// Split will serialize the route into numbered RTE sentences, each within MaxSentenceLength.
+// The WPL sentences for the route are available from Waypoints.
+func (rt Route) Split() ([]RTE, error) {
+	// the number of digits in the sentence count affects how many waypoints fit,
+	// so repeat until the guess matches the result
+	digits := 1
+	for {
+		res, err := rt.split(digits)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(strconv.Itoa(len(res))); n > digits {
+			digits = n
+			continue
+		}
+
+		for i := range res {
+			res[i].Total = len(res)
+			res[i].Number = i + 1
+		}
+		return res, nil
+	}
+}
+
+func (rt Route) split(digits int) ([]RTE, error) {
+	// $GPRTE,<total>,<number>,c,<id>*hh<CR><LF>, with reserved characters in IDs escaped
+	base := len("$GPRTE,,,c,*hh\r\n") + 2*digits + len(encodeEscapes(rt.ID))
+	if base > MaxSentenceLength {
+		return nil, fmt.Errorf("route ID too long: %s", rt.ID)
+	}
+
+	var res []RTE
+	cur := RTE{Complete: rt.Complete, Route: rt.ID}
+	l := base
+	for _, wp := range rt.Waypoints {
+		n := len(encodeEscapes(wp.Name))
+		if base+1+n > MaxSentenceLength {
+			return nil, fmt.Errorf("waypoint ID too long: %s", wp.Name)
+		}
+		if l+1+n > MaxSentenceLength {
+			res = append(res, cur)
+			cur = RTE{Complete: rt.Complete, Route: rt.ID}
+			l = base
+		}
+		cur.Waypoints = append(cur.Waypoints, wp.Name)
+		l += 1 + n
+	}
+	return append(res, cur), nil
+}
+
+// RouteAssembler will assemble routes from multi-sentence RTE data. Locations for waypoints are
+// taken from any WPL sentences that have been added.
+type RouteAssembler struct {
+	waypoints map[string]WPL
+	pending   map[string]*RTE
+}
+
+// Add will add a sentence to the assembler. A Route is returned when the last RTE sentence for it
+// has been added, otherwise the returned Route is nil. Sentences other than RTE and WPL are ignored.
+func (a *RouteAssembler) Add(s Sentence) (*Route, error) {
+	if a.waypoints == nil {
+		a.waypoints = make(map[string]WPL)
+		a.pending = make(map[string]*RTE)
+	}
+
+	var r RTE
+	switch v := s.(type) {
+	case *WPL:
+		a.waypoints[v.Name] = *v
+		return nil, nil
+	case WPL:
+		a.waypoints[v.Name] = v
+		return nil, nil
+	case *RTE:
+		r = *v
+	case RTE:
+		r = v
+	default:
+		return nil, nil
+	}
+
+	p := a.pending[r.Route]
+	if r.Number == 1 {
+		p = &RTE{Total: r.Total, Complete: r.Complete, Route: r.Route}
+		a.pending[r.Route] = p
+	} else if p == nil || r.Number != p.Number+1 || r.Total != p.Total {
+		delete(a.pending, r.Route)
+		return nil, fmt.Errorf("unexpected RTE sentence %d of %d for route '%s'", r.Number, r.Total, r.Route)
+	}
+	p.Number = r.Number
+	p.Waypoints = append(p.Waypoints, r.Waypoints...)
+
+	if p.Number < p.Total {
+		return nil, nil
+	}
+	delete(a.pending, r.Route)
+
+	rt := &Route{ID: p.Route, Complete: p.Complete, Waypoints: make([]WPL, len(p.Waypoints))}
+	for i, name := range p.Waypoints {
+		wp, ok := a.waypoints[name]
+		if !ok {
+			wp = WPL{Name: name}
+		}
+		rt.Waypoints[i] = wp
+	}
+	return rt, nil
+}
@@ -0,0 +1,82 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoute_Split(t *testing.T) {
+	rt := Route{ID: "LONG", Complete: true}
+	for i := 0; i < 100; i++ {
+		rt.Waypoints = append(rt.Waypoints, WPL{Name: fmt.Sprintf("WAYPT%03d", i)})
+	}
+
+	res, err := rt.Split()
+	assert.Nil(t, err)
+
+	var names []string
+	for i, r := range res {
+		assert.Equal(t, len(res), r.Total, "total")
+		assert.Equal(t, i+1, r.Number, "number")
+		assert.True(t, r.Complete, "complete")
+		assert.Equal(t, "LONG", r.Route, "route")
+		assert.True(t, len(r.String())+2 <= MaxSentenceLength, "length")
+		names = append(names, r.Waypoints...)
+	}
+	assert.Len(t, names, 100)
+	assert.Equal(t, "WAYPT099", names[99])
+
+	rt.Waypoints = []WPL{{Name: string(make([]byte, 80))}}
+	_, err = rt.Split()
+	assert.NotNil(t, err)
+
+	// reserved characters are escaped as ^XX, which must be counted
+	rt = Route{ID: "A,B", Complete: true}
+	for i := 0; i < 20; i++ {
+		rt.Waypoints = append(rt.Waypoints, WPL{Name: fmt.Sprintf("W,%02d*", i)})
+	}
+	res, err = rt.Split()
+	assert.Nil(t, err)
+	names = nil
+	for _, r := range res {
+		assert.True(t, len(r.String())+2 <= MaxSentenceLength, "length")
+		names = append(names, r.Waypoints...)
+	}
+	assert.Len(t, names, 20)
+
+	rt.Waypoints = []WPL{{Name: strings.Repeat(",", 25)}}
+	_, err = rt.Split()
+	assert.NotNil(t, err)
+}
+
+func TestRouteAssembler_Add(t *testing.T) {
+	var a RouteAssembler
+	rt := Route{ID: "R1", Waypoints: []WPL{
+		{Latitude: Coord(45.5), Longitude: Coord(-120.25), Name: "START"},
+		{Latitude: Coord(45.75), Longitude: Coord(-120.5), Name: "MIDDLE"},
+		{Latitude: Coord(46), Longitude: Coord(-121), Name: "END"},
+	}}
+	for _, wp := range rt.Waypoints {
+		res, err := a.Add(wp)
+		assert.Nil(t, err)
+		assert.Nil(t, res)
+	}
+
+	res, err := a.Add(&RTE{Total: 2, Number: 1, Route: "R1", Waypoints: []string{"START", "MIDDLE"}})
+	assert.Nil(t, err)
+	assert.Nil(t, res)
+
+	res, err = a.Add(&RTE{Total: 2, Number: 2, Route: "R1", Waypoints: []string{"END", "UNKNOWN"}})
+	assert.Nil(t, err)
+	if assert.NotNil(t, res) {
+		assert.Equal(t, "R1", res.ID)
+		assert.False(t, res.Complete)
+		assert.EqualValues(t, append(rt.Waypoints, WPL{Name: "UNKNOWN"}), res.Waypoints)
+	}
+
+	_, err = a.Add(&RTE{Total: 2, Number: 2, Route: "R1", Waypoints: []string{"END"}})
+	assert.NotNil(t, err)
+}
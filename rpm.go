@@ -0,0 +1,88 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// RPMSource is the source of an RPM measurement
+type RPMSource string
+
+// Sources for RPM
+const (
+	RPMSourceShaft  RPMSource = "S"
+	RPMSourceEngine RPMSource = "E"
+)
+
+// RPM reports the rotational speed of a shaft or engine and the propeller pitch
+type RPM struct {
+	Talker string    `csv:"talker"`    // talker ID, defaults to II (integrated instrumentation) when serializing
+	Source RPMSource `csv:"source"`    // if the measurement is from a shaft or engine
+	Number int       `csv:"number"`    // shaft or engine number, numbered from centerline, odd to starboard
+	Speed  float64   `csv:"speed_rpm"` // speed in revolutions per minute, negative for counter-clockwise. NaN if missing or invalid
+	Pitch  float64   `csv:"pitch_pct"` // propeller pitch in percent of maximum, negative for astern. NaN if missing or invalid
+}
+
+// Type returns TypeRPM to fulfill the Sentence interface
+func (p RPM) Type() Type {
+	return TypeRPM
+}
+
+// String will provide a NMEA formatted string. The data is reported as invalid if Speed is NaN
+func (p RPM) String() string {
+	return Raw{
+		TypeName: typeName(p.Talker, "II", TypeRPM),
+		Fields: []string{
+			string(p.Source),
+			strconv.Itoa(p.Number),
+			formatFieldOptFloat(p.Speed),
+			formatFieldOptFloat(p.Pitch),
+			formatFieldStatus(!math.IsNaN(p.Speed)),
+		},
+	}.String()
+}
+
+// Parse will parse RPM data from a raw sentence struct. If the status is not valid, Speed and Pitch are set to NaN
+func (p *RPM) Parse(r *Raw) error {
+	var err error
+	p.Talker, err = parseTalker(r, TypeRPM)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	switch RPMSource(r.Fields[0]) {
+	case RPMSourceShaft, RPMSourceEngine:
+		p.Source = RPMSource(r.Fields[0])
+	default:
+		return fmt.Errorf("invalid source: %s", r.Fields[0])
+	}
+
+	p.Number, err = parseFieldInt(r.Fields[1], "Number")
+	if err != nil {
+		return err
+	}
+
+	p.Speed, err = parseFieldOptFloat(r.Fields[2], "Speed")
+	if err != nil {
+		return err
+	}
+	p.Pitch, err = parseFieldOptFloat(r.Fields[3], "Pitch")
+	if err != nil {
+		return err
+	}
+
+	valid, err := parseFieldStatus(r.Fields[4], "status")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		p.Speed = math.NaN()
+		p.Pitch = math.NaN()
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rpmStr = "$IIRPM,E,1,2418.2,10.5,A*5F"
+
+func TestRPM_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rpmStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := new(RPM)
+	err = p.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeRPM, p.Type(), "type")
+	assert.Equal(t, RPMSourceEngine, p.Source, "source")
+	assert.Equal(t, 1, p.Number, "number")
+	assert.Equal(t, 2418.2, p.Speed, "speed")
+	assert.Equal(t, 10.5, p.Pitch, "pitch")
+
+	r, err = ParseRaw([]byte("$IIRPM,S,2,,,V*54"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Parse(r)
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(p.Speed), "missing speed")
+	assert.True(t, math.IsNaN(p.Pitch), "missing pitch")
+}
+
+func TestRPM_String(t *testing.T) {
+	str := RPM{Source: RPMSourceShaft, Number: 2, Speed: 1200, Pitch: -5}.String()
+
+	assert.Equal(t, "$IIRPM,S,2,1200,-5,A*58", str)
+}
@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// RSA reports the rudder angle. Vessels with a single rudder report only Starboard, and must
+// set Port to NaN, as a zero angle is written as valid. NewRSA does this.
+type RSA struct {
+	Talker    string  `csv:"talker"`        // talker ID, defaults to II (integrated instrumentation) when serializing
+	Starboard float64 `csv:"starboard_deg"` // starboard (or single) rudder angle in degrees, negative to port. NaN if missing or invalid
+	Port      float64 `csv:"port_deg"`      // port rudder angle in degrees, negative to port. NaN if missing or invalid
+}
+
+// NewRSA will return an RSA with both angles missing (NaN)
+func NewRSA() RSA {
+	return RSA{Starboard: math.NaN(), Port: math.NaN()}
+}
+
+// Type returns TypeRSA to fulfill the Sentence interface
+func (s RSA) Type() Type {
+	return TypeRSA
+}
+
+// String will provide a NMEA formatted string. NaN angles are written as missing and invalid
+func (s RSA) String() string {
+	return Raw{
+		TypeName: typeName(s.Talker, "II", TypeRSA),
+		Fields: []string{
+			formatFieldOptFloat(s.Starboard),
+			formatFieldStatus(!math.IsNaN(s.Starboard)),
+			formatFieldOptFloat(s.Port),
+			formatFieldStatus(!math.IsNaN(s.Port)),
+		},
+	}.String()
+}
+
+// Parse will parse RSA data from a raw sentence struct. Angles with an invalid status are set to NaN
+func (s *RSA) Parse(r *Raw) error {
+	var err error
+	s.Talker, err = parseTalker(r, TypeRSA)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 4 {
+		return fmt.Errorf("not enough fields, need at least 4")
+	}
+
+	s.Starboard, err = parseRudderAngle(r.Fields[0], r.Fields[1], "Starboard")
+	if err != nil {
+		return err
+	}
+	s.Port, err = parseRudderAngle(r.Fields[2], r.Fields[3], "Port")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseRudderAngle(val, stat, name string) (float64, error) {
+	angle, err := parseFieldOptFloat(val, name)
+	if err != nil {
+		return 0, err
+	}
+	valid, err := parseFieldStatus(stat, name+" status")
+	if err != nil {
+		return 0, err
+	}
+	if !valid {
+		return math.NaN(), nil
+	}
+	return angle, nil
+}
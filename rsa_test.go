@@ -0,0 +1,37 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rsaStr = "$IIRSA,10.5,A,,V*4D"
+
+func TestRSA_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rsaStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := new(RSA)
+	err = s.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeRSA, s.Type(), "type")
+	assert.Equal(t, 10.5, s.Starboard, "starboard")
+	assert.True(t, math.IsNaN(s.Port), "port")
+}
+
+func TestRSA_String(t *testing.T) {
+	assert.Equal(t, "$IIRSA,-3,A,-2.5,A*5A", RSA{Starboard: -3, Port: -2.5}.String())
+	assert.Equal(t, "$IIRSA,10.5,A,,V*4D", RSA{Starboard: 10.5, Port: math.NaN()}.String())
+
+	// single rudder
+	s := NewRSA()
+	s.Starboard = 10.5
+	assert.Equal(t, "$IIRSA,10.5,A,,V*4D", s.String())
+	assert.Equal(t, "$IIRSA,,V,,V*40", NewRSA().String())
+	assert.Equal(t, "$IIRSA,10.5,A,0,A*6A", RSA{Starboard: 10.5}.String())
+}
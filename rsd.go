@@ -0,0 +1,113 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RSDRotation is the display rotation of a radar
+type RSDRotation string
+
+// Display rotations for RSD
+const (
+	RSDRotationCourseUp RSDRotation = "C"
+	RSDRotationHeadUp   RSDRotation = "H"
+	RSDRotationNorthUp  RSDRotation = "N"
+)
+
+// RSDOrigin is a range and bearing from own ship, used for display origins and the cursor
+type RSDOrigin struct {
+	Range   float64 `csv:"range_nm"`    // range in nautical miles
+	Bearing float64 `csv:"bearing_deg"` // bearing in degrees from own ship heading
+}
+
+// RSD reports radar system display settings
+type RSD struct {
+	Talker     string      `csv:"talker"`         // talker ID, defaults to RA (radar) when serializing
+	Origin1    RSDOrigin   `csv:"origin1"`        // first display origin
+	VRM1       float64     `csv:"vrm1_nm"`        // first variable range marker in nautical miles
+	EBL1       float64     `csv:"ebl1_deg"`       // first electronic bearing line in degrees
+	Origin2    RSDOrigin   `csv:"origin2"`        // second display origin
+	VRM2       float64     `csv:"vrm2_nm"`        // second variable range marker in nautical miles
+	EBL2       float64     `csv:"ebl2_deg"`       // second electronic bearing line in degrees
+	Cursor     RSDOrigin   `csv:"cursor"`         // cursor position
+	RangeScale float64     `csv:"range_scale_nm"` // range scale in use in nautical miles
+	Rotation   RSDRotation `csv:"rotation"`       // display rotation
+}
+
+// Type returns TypeRSD to fulfill the Sentence interface
+func (d RSD) Type() Type {
+	return TypeRSD
+}
+
+// String will provide a NMEA formatted string. Ranges are written in nautical miles
+func (d RSD) String() string {
+	vals := []float64{
+		d.Origin1.Range, d.Origin1.Bearing, d.VRM1, d.EBL1,
+		d.Origin2.Range, d.Origin2.Bearing, d.VRM2, d.EBL2,
+		d.Cursor.Range, d.Cursor.Bearing, d.RangeScale,
+	}
+	fields := make([]string, 0, 13)
+	for _, v := range vals {
+		fields = append(fields, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	fields = append(fields, string(SpeedUnitKnots), string(d.Rotation))
+
+	return Raw{
+		TypeName: typeName(d.Talker, "RA", TypeRSD),
+		Fields:   fields,
+	}.String()
+}
+
+// Parse will parse RSD data from a raw sentence struct. Ranges are converted to nautical miles
+func (d *RSD) Parse(r *Raw) error {
+	var err error
+	d.Talker, err = parseTalker(r, TypeRSD)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 13 {
+		return fmt.Errorf("not enough fields, need at least 13")
+	}
+
+	unit, err := parseDistanceUnit(r.Fields[11], "RangeScale")
+	if err != nil {
+		return err
+	}
+
+	vals := []struct {
+		v     *float64
+		name  string
+		isRng bool
+	}{
+		{&d.Origin1.Range, "Origin1 range", true},
+		{&d.Origin1.Bearing, "Origin1 bearing", false},
+		{&d.VRM1, "VRM1", true},
+		{&d.EBL1, "EBL1", false},
+		{&d.Origin2.Range, "Origin2 range", true},
+		{&d.Origin2.Bearing, "Origin2 bearing", false},
+		{&d.VRM2, "VRM2", true},
+		{&d.EBL2, "EBL2", false},
+		{&d.Cursor.Range, "Cursor range", true},
+		{&d.Cursor.Bearing, "Cursor bearing", false},
+		{&d.RangeScale, "RangeScale", true},
+	}
+	for i, f := range vals {
+		*f.v, err = parseFieldFloat(r.Fields[i], f.name)
+		if err != nil {
+			return err
+		}
+		if f.isRng {
+			*f.v = toNauticalMiles(*f.v, unit)
+		}
+	}
+
+	switch RSDRotation(r.Fields[12]) {
+	case RSDRotationCourseUp, RSDRotationHeadUp, RSDRotationNorthUp, RSDRotation(""):
+		d.Rotation = RSDRotation(r.Fields[12])
+	default:
+		return fmt.Errorf("invalid display rotation: %s", r.Fields[12])
+	}
+
+	return nil
+}
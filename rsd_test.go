@@ -0,0 +1,43 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rsdStr = "$RARSD,0.5,45,1.5,90,,,,,2.25,315,6,N,H*6F"
+
+func TestRSD_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rsdStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(RSD)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeRSD, d.Type(), "type")
+	assert.Equal(t, RSDOrigin{Range: 0.5, Bearing: 45}, d.Origin1, "origin 1")
+	assert.Equal(t, 1.5, d.VRM1, "VRM 1")
+	assert.Equal(t, 90.0, d.EBL1, "EBL 1")
+	assert.Equal(t, RSDOrigin{}, d.Origin2, "origin 2")
+	assert.Equal(t, RSDOrigin{Range: 2.25, Bearing: 315}, d.Cursor, "cursor")
+	assert.Equal(t, 6.0, d.RangeScale, "range scale")
+	assert.Equal(t, RSDRotationHeadUp, d.Rotation, "rotation")
+}
+
+func TestRSD_String(t *testing.T) {
+	str := RSD{
+		VRM1:       1,
+		EBL1:       10,
+		VRM2:       2,
+		EBL2:       20,
+		Cursor:     RSDOrigin{Range: 0.5, Bearing: 45},
+		RangeScale: 3,
+		Rotation:   RSDRotationNorthUp,
+	}.String()
+
+	assert.Equal(t, "$RARSD,0,0,1,10,0,0,2,20,0.5,45,3,N,N*63", str)
+}
@@ -0,0 +1,80 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RTE reports the waypoints of a route. Routes with many waypoints are split across multiple RTE sentences
+type RTE struct {
+	Talker    string   `csv:"talker"`    // talker ID, defaults to GP when serializing
+	Total     int      `csv:"total"`     // total number of sentences for the route
+	Number    int      `csv:"number"`    // number of this sentence, starting at 1
+	Complete  bool     `csv:"complete"`  // true if this is a complete route (c), false for the working route (w)
+	Route     string   `csv:"route"`     // route ID
+	Waypoints []string `csv:"waypoints"` // waypoint IDs
+}
+
+// Type returns TypeRTE to fulfill the Sentence interface
+func (rt RTE) Type() Type {
+	return TypeRTE
+}
+
+// String will provide a NMEA formatted string
+func (rt RTE) String() string {
+	mode := "w"
+	if rt.Complete {
+		mode = "c"
+	}
+	return Raw{
+		TypeName: typeName(rt.Talker, "GP", TypeRTE),
+		Fields: append([]string{
+			strconv.Itoa(rt.Total),
+			strconv.Itoa(rt.Number),
+			mode,
+			rt.Route,
+		}, rt.Waypoints...),
+	}.String()
+}
+
+// Parse will parse RTE data from a raw sentence struct
+func (rt *RTE) Parse(r *Raw) error {
+	var err error
+	rt.Talker, err = parseTalker(r, TypeRTE)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 4 {
+		return fmt.Errorf("not enough fields, need at least 4")
+	}
+
+	rt.Total, err = parseFieldInt(r.Fields[0], "Total")
+	if err != nil {
+		return err
+	}
+	rt.Number, err = parseFieldInt(r.Fields[1], "Number")
+	if err != nil {
+		return err
+	}
+
+	switch r.Fields[2] {
+	case "c":
+		rt.Complete = true
+	case "w":
+		rt.Complete = false
+	default:
+		return fmt.Errorf("invalid route mode: %s", r.Fields[2])
+	}
+
+	rt.Route = r.Fields[3]
+
+	rt.Waypoints = make([]string, 0, len(r.Fields)-4)
+	for _, wp := range r.Fields[4:] {
+		if wp == "" {
+			continue
+		}
+		rt.Waypoints = append(rt.Waypoints, wp)
+	}
+
+	return nil
+}
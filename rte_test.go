@@ -0,0 +1,33 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rteStr = "$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73"
+
+func TestRTE_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rteStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rt := new(RTE)
+	err = rt.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeRTE, rt.Type(), "type")
+	assert.Equal(t, 2, rt.Total, "total")
+	assert.Equal(t, 1, rt.Number, "number")
+	assert.True(t, rt.Complete, "complete")
+	assert.Equal(t, "0", rt.Route, "route")
+	assert.EqualValues(t, []string{"PBRCPK", "PBRTO", "PTELGR", "PPLAND", "PYAMBU", "PPFAIR", "PWARRN", "PMORTL", "PLISMR"}, rt.Waypoints)
+}
+
+func TestRTE_String(t *testing.T) {
+	str := RTE{Total: 1, Number: 1, Route: "R1", Waypoints: []string{"A", "B", "C"}}.String()
+
+	assert.Equal(t, "$GPRTE,1,1,w,R1,A,B,C*2C", str)
+}
@@ -0,0 +1,276 @@
+package nmea
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// SimWaypoint is a point on a simulated trajectory
+type SimWaypoint struct {
+	Latitude  Coord
+	Longitude Coord
+	Speed     float64 // speed in knots on the leg to the next waypoint
+}
+
+// SimFaults configures the faults injected by a Simulator. Each fault is applied at random with
+// the given probability, using a generator seeded with Seed so that runs are repeatable.
+type SimFaults struct {
+	Seed int64
+
+	FixLoss         float64       // probability per epoch of losing the fix
+	FixLossDuration time.Duration // how long the fix is lost for, defaults to one epoch
+
+	Jump         float64 // probability per epoch of the position jumping away from the trajectory
+	JumpDistance float64 // distance of a jump in nautical miles, defaults to 1
+
+	DOPDegradation float64 // probability per epoch of degraded dilution of precision
+	DOPFactor      float64 // factor the DOP values are multiplied by when degraded, defaults to 5
+
+	Checksum float64 // probability per line of a corrupted checksum
+	Truncate float64 // probability per line of the line being cut short
+}
+
+// Simulator emits the sentences of a GNSS receiver following a trajectory. Each epoch produces a
+// coherent set of GPRMC, GPGGA, GPGSA, GSV and VTG sentences sharing the same time and position.
+type Simulator struct {
+	// Waypoints is the trajectory, followed from the first waypoint at the speed of each leg. The
+	// receiver stays at the last waypoint, so a single waypoint is a static position.
+	Waypoints []SimWaypoint
+
+	Start     time.Time     // time of the first epoch, defaults to the current time
+	Interval  time.Duration // time between epochs, defaults to 1 second (1 Hz)
+	Altitude  float64       // altitude in meters
+	Variation Coord         // magnetic variation
+
+	// Satellites in view, defaults to a fixed constellation. Satellites with a valid SNR and an
+	// elevation of at least 10 degrees are used for the fix.
+	Satellites []GSVSatellite
+
+	HDOP, VDOP float64 // dilution of precision, default to 0.9 and 1.2
+
+	Faults SimFaults
+
+	epoch     int
+	rand      *rand.Rand
+	lostUntil time.Time
+}
+
+// defaultSimSatellites is the constellation used when a Simulator has no Satellites
+var defaultSimSatellites = []GSVSatellite{
+	{PRN: "02", Elevation: 67, Azimuth: 312, SNR: 45},
+	{PRN: "05", Elevation: 41, Azimuth: 58, SNR: 42},
+	{PRN: "07", Elevation: 22, Azimuth: 141, SNR: 36},
+	{PRN: "09", Elevation: 14, Azimuth: 203, SNR: 31},
+	{PRN: "13", Elevation: 55, Azimuth: 97, SNR: 44},
+	{PRN: "15", Elevation: 33, Azimuth: 268, SNR: 39},
+	{PRN: "18", Elevation: 6, Azimuth: 20, SNR: 22},
+	{PRN: "20", Elevation: 28, Azimuth: 176, SNR: 37},
+	{PRN: "26", Elevation: 48, Azimuth: 233, SNR: 43},
+	{PRN: "29", Elevation: 3, Azimuth: 345, SNR: math.NaN()},
+}
+
+func (s *Simulator) interval() time.Duration {
+	if s.Interval <= 0 {
+		return time.Second
+	}
+	return s.Interval
+}
+
+func (s *Simulator) random() *rand.Rand {
+	if s.rand == nil {
+		s.rand = rand.New(rand.NewSource(s.Faults.Seed))
+	}
+	return s.rand
+}
+
+// fault will report whether a fault with probability p occurs
+func (s *Simulator) fault(p float64) bool {
+	return p > 0 && s.random().Float64() < p
+}
+
+// Position will return the position, speed and course on the trajectory at elapsed time e
+func (s *Simulator) Position(e time.Duration) (lat, lon Coord, speed, course float64) {
+	if len(s.Waypoints) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	hours := e.Hours()
+	for i, wp := range s.Waypoints[:len(s.Waypoints)-1] {
+		next := s.Waypoints[i+1]
+		dist := HaversineDistance(wp.Latitude, wp.Longitude, next.Latitude, next.Longitude) / MetersPerNauticalMile
+		course = InitialBearing(wp.Latitude, wp.Longitude, next.Latitude, next.Longitude)
+		if wp.Speed <= 0 {
+			return wp.Latitude, wp.Longitude, 0, course
+		}
+		legHours := dist / wp.Speed
+		if hours < legHours {
+			lat, lon = Destination(wp.Latitude, wp.Longitude, course, wp.Speed*hours*MetersPerNauticalMile)
+			course = InitialBearing(lat, lon, next.Latitude, next.Longitude)
+			return lat, lon, wp.Speed, course
+		}
+		hours -= legHours
+	}
+
+	last := s.Waypoints[len(s.Waypoints)-1]
+	return last.Latitude, last.Longitude, 0, course
+}
+
+// Next will return the sentences for the next epoch
+func (s *Simulator) Next() []Sentence {
+	if s.Start.IsZero() {
+		s.Start = time.Now().UTC().Truncate(s.interval())
+	}
+	t := s.Start.Add(time.Duration(s.epoch) * s.interval())
+	lat, lon, speed, course := s.Position(time.Duration(s.epoch) * s.interval())
+	s.epoch++
+
+	if s.fault(s.Faults.FixLoss) {
+		d := s.Faults.FixLossDuration
+		if d <= 0 {
+			d = s.interval()
+		}
+		s.lostUntil = t.Add(d)
+	}
+	lost := t.Before(s.lostUntil)
+
+	if s.fault(s.Faults.Jump) {
+		dist := s.Faults.JumpDistance
+		if dist <= 0 {
+			dist = 1
+		}
+		lat, lon = Destination(lat, lon, s.random().Float64()*360, dist*MetersPerNauticalMile)
+	}
+
+	hdop, vdop := s.HDOP, s.VDOP
+	if hdop <= 0 {
+		hdop = 0.9
+	}
+	if vdop <= 0 {
+		vdop = 1.2
+	}
+	if s.fault(s.Faults.DOPDegradation) {
+		factor := s.Faults.DOPFactor
+		if factor <= 0 {
+			factor = 5
+		}
+		hdop *= factor
+		vdop *= factor
+	}
+	hdop, vdop = round(hdop, 1), round(vdop, 1)
+	pdop := round(math.Hypot(hdop, vdop), 1)
+
+	// round to what a receiver would report
+	lat, lon = Coord(round(float64(lat)*60, 4)/60), Coord(round(float64(lon)*60, 4)/60)
+	speed, course = round(speed, 1), round(course, 1)
+
+	sats := s.Satellites
+	if sats == nil {
+		sats = defaultSimSatellites
+	}
+	var used []string
+	inView := make([]GSVSatellite, len(sats))
+	for i, sat := range sats {
+		if lost {
+			sat.SNR = math.NaN()
+		}
+		inView[i] = sat
+		if !math.IsNaN(sat.SNR) && sat.Elevation >= 10 && len(used) < 12 {
+			used = append(used, sat.PRN)
+		}
+	}
+
+	rmc := &GPRMC{
+		Time:       t,
+		Active:     true,
+		Latitude:   lat,
+		Longitude:  lon,
+		Speed:      speed,
+		TrueCourse: course,
+		Variation:  s.Variation,
+		FixType:    GPRMCFixAutonomous,
+	}
+	gga := &GPGGA{
+		Time:       t,
+		Latitude:   lat,
+		Longitude:  lon,
+		FixType:    GPGGAFixGPS,
+		Satellites: len(used),
+		HDOP:       hdop,
+		Altitude:   s.Altitude,
+	}
+	gsa := &GPGSA{
+		AutoSelection: true,
+		FixType:       GPGSAFix3D,
+		Satellites:    used,
+		PDOP:          pdop,
+		HDOP:          hdop,
+		VDOP:          vdop,
+	}
+	vtg := &VTG{
+		TrueCourse:     course,
+		MagneticCourse: round(MagneticFromTrue(course, s.Variation), 1),
+		Speed:          speed,
+		FixType:        GPRMCFixAutonomous,
+	}
+	if lost {
+		rmc.Active, rmc.FixType = false, GPRMCFixNotValid
+		gga.FixType, gga.HDOP = GPGGAFixInvalid, 99.99
+		gsa.FixType, gsa.Satellites = GPGSAFixNoFix, nil
+		gsa.PDOP, gsa.HDOP, gsa.VDOP = 99.99, 99.99, 99.99
+		vtg.FixType = GPRMCFixNotValid
+	}
+
+	res := []Sentence{rmc, gga, gsa}
+	for _, gsv := range SplitGSV("GP", inView) {
+		gsv := gsv
+		res = append(res, &gsv)
+	}
+	return append(res, vtg)
+}
+
+// NextLines will return the lines for the next epoch, with checksum and truncation faults applied
+func (s *Simulator) NextLines() []string {
+	sentences := s.Next()
+	lines := make([]string, len(sentences))
+	for i, sen := range sentences {
+		line := sen.String()
+		if s.fault(s.Faults.Checksum) {
+			check, _ := strconv.ParseUint(line[len(line)-2:], 16, 8)
+			line = fmt.Sprintf("%s%02X", line[:len(line)-2], uint8(check+1))
+		}
+		if s.fault(s.Faults.Truncate) {
+			line = line[:1+s.random().Intn(len(line)-1)]
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+// Run will write the lines of each epoch to w, followed by <CR><LF>, in real time at the configured
+// interval until done is closed or writing fails.
+func (s *Simulator) Run(w io.Writer, done <-chan struct{}) error {
+	ticker := time.NewTicker(s.interval())
+	defer ticker.Stop()
+	for {
+		for _, line := range s.NextLines() {
+			if _, err := io.WriteString(w, line+"\r\n"); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// round will round f to the given number of decimal places
+func round(f float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(f*p) / p
+}
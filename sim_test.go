@@ -0,0 +1,133 @@
+package nmea
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulator_Position(t *testing.T) {
+	s := &Simulator{Waypoints: []SimWaypoint{
+		{Latitude: 10, Longitude: 20, Speed: 6},
+		{Latitude: Coord(10 + 1.0/60), Longitude: 20, Speed: 12},
+		{Latitude: Coord(10 + 1.0/60), Longitude: Coord(20 + 1.0/60)},
+	}}
+
+	lat, lon, speed, course := s.Position(5 * time.Minute)
+	assert.InDelta(t, 10+0.5/60, float64(lat), 0.001/60, "latitude")
+	assert.InDelta(t, 20, float64(lon), 0.001/60, "longitude")
+	assert.Equal(t, 6.0, speed, "speed")
+	assert.InDelta(t, 0, course, 0.1, "course")
+
+	// 10 minutes on the first leg, then 1 minute of 12 knots east
+	_, lon, speed, course = s.Position(11 * time.Minute)
+	assert.InDelta(t, 20+0.2/60/math.Cos(10*math.Pi/180), float64(lon), 0.01/60, "longitude")
+	assert.Equal(t, 12.0, speed, "speed")
+	assert.InDelta(t, 90, course, 0.1, "course")
+
+	lat, lon, speed, _ = s.Position(time.Hour)
+	assert.Equal(t, Coord(10+1.0/60), lat, "latitude")
+	assert.Equal(t, Coord(20+1.0/60), lon, "longitude")
+	assert.Equal(t, 0.0, speed, "speed")
+}
+
+func TestSimulator_Next(t *testing.T) {
+	start := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	s := &Simulator{
+		Waypoints: []SimWaypoint{{Latitude: 45.51, Longitude: -93.25, Speed: 10}, {Latitude: 46, Longitude: -93.25}},
+		Start:     start,
+		Interval:  100 * time.Millisecond,
+	}
+
+	for i := 0; i < 3; i++ {
+		lines := s.NextLines()
+		if !assert.Len(t, lines, 7) {
+			return
+		}
+		var sentences []Sentence
+		for _, line := range lines {
+			assert.True(t, len(line)+2 <= MaxSentenceLength, line)
+			sen, err := Parse([]byte(line))
+			if assert.Nil(t, err, line) {
+				sentences = append(sentences, sen)
+			}
+		}
+
+		ts := start.Add(time.Duration(i) * 100 * time.Millisecond)
+		rmc := sentences[0].(*GPRMC)
+		gga := sentences[1].(*GPGGA)
+		assert.Equal(t, ts, rmc.Time, "rmc time")
+		assert.Equal(t, ts.Hour(), gga.Time.Hour(), "gga time")
+		assert.Equal(t, ts.Nanosecond(), gga.Time.Nanosecond(), "gga time")
+		assert.True(t, rmc.Active)
+		assert.Equal(t, 10.0, rmc.Speed)
+		assert.Equal(t, rmc.Latitude, gga.Latitude)
+		assert.Equal(t, GPGGAFixGPS, gga.FixType)
+		assert.Equal(t, 8, gga.Satellites)
+		assert.Len(t, sentences[2].(*GPGSA).Satellites, 8)
+		assert.Equal(t, 10, sentences[3].(*GSV).InView)
+		assert.Equal(t, 10.0, sentences[6].(*VTG).Speed)
+	}
+}
+
+func TestSimulator_faults(t *testing.T) {
+	s := &Simulator{
+		Waypoints: []SimWaypoint{{Latitude: 45.51, Longitude: -93.25}},
+		Start:     time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+		Faults:    SimFaults{FixLoss: 1, DOPDegradation: 1},
+	}
+	sentences := s.Next()
+	assert.False(t, sentences[0].(*GPRMC).Active)
+	assert.Equal(t, GPGGAFixInvalid, sentences[1].(*GPGGA).FixType)
+	assert.Equal(t, GPGSAFixNoFix, sentences[2].(*GPGSA).FixType)
+
+	s.Faults = SimFaults{DOPDegradation: 1, DOPFactor: 10}
+	sentences = s.Next()
+	assert.True(t, sentences[0].(*GPRMC).Active)
+	assert.Equal(t, 9.0, sentences[1].(*GPGGA).HDOP)
+
+	s.Faults = SimFaults{Jump: 1, JumpDistance: 5}
+	dist := HaversineDistance(45.51, -93.25, s.Next()[0].(*GPRMC).Latitude, -93.25)
+	assert.True(t, dist > 0.1*MetersPerNauticalMile, "jump")
+
+	s.Faults = SimFaults{Checksum: 1}
+	for _, line := range s.NextLines() {
+		_, err := ParseRaw([]byte(line))
+		assert.NotNil(t, err, line)
+		assert.True(t, strings.Contains(err.Error(), "checksum"), err.Error())
+	}
+
+	// truncated lines either lose their checksum or fail to parse
+	s.Faults = SimFaults{Truncate: 1}
+	for _, line := range s.NextLines() {
+		_, err := ParseRaw([]byte(line))
+		assert.True(t, err != nil || !strings.Contains(line, "*"), line)
+	}
+}
+
+func TestSimulator_longitudeAbove100(t *testing.T) {
+	for _, lon := range []Coord{151.2093, -122.4194} {
+		s := &Simulator{
+			Waypoints: []SimWaypoint{{Latitude: -33.8688, Longitude: lon}},
+			Start:     time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+		}
+		lines := s.NextLines()
+		for _, line := range lines[:2] {
+			sen, err := Parse([]byte(line))
+			if !assert.Nil(t, err, line) {
+				continue
+			}
+			var got Coord
+			switch v := sen.(type) {
+			case *GPRMC:
+				got = v.Longitude
+			case *GPGGA:
+				got = v.Longitude
+			}
+			assert.InDelta(t, float64(lon), float64(got), 0.0001/60, line)
+		}
+	}
+}
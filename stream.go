@@ -0,0 +1,100 @@
+package nmea
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// SentenceReader is implemented by sources of sentences
+type SentenceReader interface {
+	ReadSentence() (Sentence, error)
+}
+
+// SentenceWriter is implemented by destinations of sentences
+type SentenceWriter interface {
+	WriteSentence(Sentence) error
+}
+
+// LineError is returned when a received line could not be parsed. Reading may continue after a LineError.
+type LineError struct {
+	Line string
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return "parse '" + e.Line + "': " + e.Err.Error()
+}
+
+// Reader reads line-delimited sentences from an io.Reader
+type Reader struct {
+	br *bufio.Reader
+}
+
+// NewReader will return a Reader reading from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{br: bufio.NewReader(r)}
+}
+
+// ReadRaw will return the next non-empty line as a Raw struct. If the line can not be parsed,
+// a *LineError is returned.
+func (r *Reader) ReadRaw() (*Raw, error) {
+	for {
+		line, err := r.br.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		raw, perr := ParseRaw(line)
+		if perr != nil {
+			return nil, &LineError{Line: string(line), Err: perr}
+		}
+		return raw, nil
+	}
+}
+
+// ReadSentence will return the next sentence. Sentences of an unknown type are returned as *Raw.
+// If the line can not be parsed, a *LineError is returned.
+func (r *Reader) ReadSentence() (Sentence, error) {
+	raw, err := r.ReadRaw()
+	if err != nil {
+		return nil, err
+	}
+	s, err := parseRawSentence(raw)
+	if err != nil {
+		return nil, &LineError{Line: raw.String(), Err: err}
+	}
+	return s, nil
+}
+
+// Writer writes sentences to an io.Writer, each terminated by <CR><LF>
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter will return a Writer writing to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteSentence will write the sentence followed by <CR><LF>
+func (w *Writer) WriteSentence(s Sentence) error {
+	_, err := io.WriteString(w.w, s.String()+"\r\n")
+	return err
+}
+
+// splitLines will split a buffer of sentences, such as a datagram, into non-empty lines
+func splitLines(b []byte) [][]byte {
+	var lines [][]byte
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		lines = append(lines, append([]byte(nil), line...))
+	}
+	return lines
+}
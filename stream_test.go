@@ -0,0 +1,43 @@
+package nmea
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReader_ReadSentence(t *testing.T) {
+	r := NewReader(strings.NewReader(gprmcStr + "\r\n\r\n$GPXXX*00\r\n$PXYZ,1*16\r\n" + gpggaStr))
+
+	s, err := r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPRMC, s.Type())
+
+	_, err = r.ReadSentence()
+	if assert.IsType(t, &LineError{}, err) {
+		assert.Equal(t, "$GPXXX*00", err.(*LineError).Line)
+	}
+
+	s, err = r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, Type("PXYZ"), s.Type())
+
+	// final line without a terminator
+	s, err = r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPGGA, s.Type())
+
+	_, err = r.ReadSentence()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestWriter_WriteSentence(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	assert.Nil(t, w.WriteSentence(HDT{Talker: "HE", Heading: 91.5}))
+	assert.Nil(t, w.WriteSentence(Raw{TypeName: "PXYZ", Fields: []string{"1"}}))
+	assert.Equal(t, HDT{Talker: "HE", Heading: 91.5}.String()+"\r\n$PXYZ,1*16\r\n", buf.String())
+}
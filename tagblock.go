@@ -0,0 +1,182 @@
+package nmea
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TagGroup identifies a sentence as part of a group of related sentences in a TAG block
+type TagGroup struct {
+	Sentence int // number of this sentence in the group, starting at 1
+	Total    int // total number of sentences in the group
+	ID       int // group identifier
+}
+
+// TagBlock is an IEC 61162-1 (NMEA 4.x) TAG block that may precede a sentence. Zero values are omitted when serializing.
+type TagBlock struct {
+	Source       string    // s: source identifier
+	Destination  string    // d: destination identifier
+	Time         time.Time // c: receiver timestamp
+	Group        TagGroup  // g: sentence grouping
+	LineCount    int       // n: line count
+	RelativeTime int64     // r: relative time
+	Text         string    // t: text string
+}
+
+// ParseTagBlock will parse a TAG block, including the enclosing '\' characters, validating the checksum (if any).
+//
+// The c: timestamp is specified in seconds since the UNIX epoch, but is commonly sent in milliseconds for
+// better resolution. Values too large to be a timestamp in seconds (after the year 5138) are treated as milliseconds.
+func ParseTagBlock(s string) (*TagBlock, error) {
+	if len(s) < 2 || s[0] != '\\' || s[len(s)-1] != '\\' {
+		return nil, errors.New("TAG block must be enclosed by '\\'")
+	}
+	s = s[1 : len(s)-1]
+
+	if len(s) >= 3 && s[len(s)-3] == '*' {
+		check := make([]byte, 1)
+		_, err := hex.Decode(check, []byte(s[len(s)-2:]))
+		if err != nil {
+			return nil, fmt.Errorf("parse TAG block checksum: %s", err)
+		}
+
+		s = s[:len(s)-3]
+		if Checksum([]byte(s)) != check[0] {
+			return nil, fmt.Errorf("TAG block checksum: expected 0x%02x but found 0x%02x", Checksum([]byte(s)), check[0])
+		}
+	}
+
+	t := new(TagBlock)
+	if s == "" {
+		return t, nil
+	}
+	for _, param := range strings.Split(s, ",") {
+		if len(param) < 2 || param[1] != ':' {
+			return nil, fmt.Errorf("invalid TAG block parameter '%s'", param)
+		}
+		val := param[2:]
+
+		var err error
+		switch param[0] {
+		case 's':
+			t.Source = val
+		case 'd':
+			t.Destination = val
+		case 't':
+			t.Text = val
+		case 'c':
+			var ts int64
+			ts, err = strconv.ParseInt(val, 10, 64)
+			if ts > 1e11 {
+				t.Time = time.Unix(0, ts*int64(time.Millisecond)).UTC()
+			} else {
+				t.Time = time.Unix(ts, 0).UTC()
+			}
+		case 'n':
+			t.LineCount, err = strconv.Atoi(val)
+		case 'r':
+			t.RelativeTime, err = strconv.ParseInt(val, 10, 64)
+		case 'g':
+			parts := strings.Split(val, "-")
+			if len(parts) != 3 {
+				return nil, fmt.Errorf("invalid TAG block group '%s'", val)
+			}
+			t.Group.Sentence, err = strconv.Atoi(parts[0])
+			if err == nil {
+				t.Group.Total, err = strconv.Atoi(parts[1])
+			}
+			if err == nil {
+				t.Group.ID, err = strconv.Atoi(parts[2])
+			}
+		default:
+			return nil, fmt.Errorf("unknown TAG block parameter '%c'", param[0])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse TAG block parameter '%c': %s", param[0], err)
+		}
+	}
+
+	return t, nil
+}
+
+// String will return the TAG block including its checksum and the enclosing '\' characters.
+// Time is written in seconds, or in milliseconds if it has a sub-second component.
+func (t TagBlock) String() string {
+	params := make([]string, 0, 7)
+	if t.Source != "" {
+		params = append(params, "s:"+t.Source)
+	}
+	if t.Destination != "" {
+		params = append(params, "d:"+t.Destination)
+	}
+	if !t.Time.IsZero() {
+		if t.Time.Nanosecond() != 0 {
+			params = append(params, "c:"+strconv.FormatInt(t.Time.UnixNano()/int64(time.Millisecond), 10))
+		} else {
+			params = append(params, "c:"+strconv.FormatInt(t.Time.Unix(), 10))
+		}
+	}
+	if t.Group != (TagGroup{}) {
+		params = append(params, fmt.Sprintf("g:%d-%d-%d", t.Group.Sentence, t.Group.Total, t.Group.ID))
+	}
+	if t.LineCount != 0 {
+		params = append(params, "n:"+strconv.Itoa(t.LineCount))
+	}
+	if t.RelativeTime != 0 {
+		params = append(params, "r:"+strconv.FormatInt(t.RelativeTime, 10))
+	}
+	if t.Text != "" {
+		params = append(params, "t:"+t.Text)
+	}
+
+	data := strings.Join(params, ",")
+	return fmt.Sprintf("\\%s*%02X\\", data, Checksum([]byte(data)))
+}
+
+// Wrap will return the NMEA formatted string for s, preceded by the TAG block. If s is a Raw
+// sentence that already has a TAG block, the two are merged into one, with parameters set in t
+// taking precedence.
+func (t TagBlock) Wrap(s Sentence) string {
+	switch r := s.(type) {
+	case *Raw:
+		if r.Tag != nil {
+			t, s = r.Tag.merge(t), Raw{TypeName: r.TypeName, Fields: r.Fields, Start: r.Start}
+		}
+	case Raw:
+		if r.Tag != nil {
+			t, r.Tag = r.Tag.merge(t), nil
+			s = r
+		}
+	}
+	return t.String() + s.String()
+}
+
+// merge will return a copy of the TAG block with the non-zero parameters of o replacing its own
+func (t TagBlock) merge(o TagBlock) TagBlock {
+	if o.Source != "" {
+		t.Source = o.Source
+	}
+	if o.Destination != "" {
+		t.Destination = o.Destination
+	}
+	if !o.Time.IsZero() {
+		t.Time = o.Time
+	}
+	if o.Group != (TagGroup{}) {
+		t.Group = o.Group
+	}
+	if o.LineCount != 0 {
+		t.LineCount = o.LineCount
+	}
+	if o.RelativeTime != 0 {
+		t.RelativeTime = o.RelativeTime
+	}
+	if o.Text != "" {
+		t.Text = o.Text
+	}
+	return t
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const tagBlockStr = `\s:GP0001,c:1577836800,g:1-2-123*69\`
+
+func TestParseTagBlock(t *testing.T) {
+	tb, err := ParseTagBlock(tagBlockStr)
+	assert.Nil(t, err)
+	assert.Equal(t, "GP0001", tb.Source, "source")
+	assert.Equal(t, "2020-01-01T00:00:00Z", tb.Time.Format(time.RFC3339Nano), "time")
+	assert.Equal(t, TagGroup{Sentence: 1, Total: 2, ID: 123}, tb.Group, "group")
+
+	tb, err = ParseTagBlock(`\s:GP0001,c:1577836800123,n:42*65\`)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-01T00:00:00.123Z", tb.Time.Format(time.RFC3339Nano), "time")
+	assert.Equal(t, 42, tb.LineCount, "line count")
+
+	_, err = ParseTagBlock(`\s:GP0001,c:1577836800*00\`)
+	assert.NotNil(t, err, "bad checksum")
+	_, err = ParseTagBlock(`\x:1\`)
+	assert.NotNil(t, err, "unknown parameter")
+}
+
+func TestTagBlock_String(t *testing.T) {
+	tb := TagBlock{
+		Source: "GP0001",
+		Time:   time.Unix(1577836800, 0),
+		Group:  TagGroup{Sentence: 1, Total: 2, ID: 123},
+	}
+	assert.Equal(t, tagBlockStr, tb.String())
+
+	tb = TagBlock{Source: "GP0001", Time: time.Unix(1577836800, 123000000), LineCount: 42}
+	assert.Equal(t, `\s:GP0001,c:1577836800123,n:42*65\`, tb.String())
+
+	assert.Equal(t, tagBlockStr+"$HEHDT,91.5,T*12", TagBlock{
+		Source: "GP0001",
+		Time:   time.Unix(1577836800, 0),
+		Group:  TagGroup{Sentence: 1, Total: 2, ID: 123},
+	}.Wrap(HDT{Heading: 91.5}))
+}
+
+func TestTagBlock_WrapTagged(t *testing.T) {
+	r, err := ParseRaw([]byte(tagBlockStr + "$HEHDT,91.5,T*12"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := *r.Tag
+
+	for _, s := range []Sentence{r, *r} {
+		line := TagBlock{Source: "II0002", LineCount: 7}.Wrap(s)
+		assert.Equal(t, 2, strings.Count(line, `\`), "one TAG block")
+
+		res, err := ParseRaw([]byte(line))
+		assert.Nil(t, err)
+		assert.Equal(t, "HEHDT", res.TypeName)
+		if assert.NotNil(t, res.Tag) {
+			assert.Equal(t, "II0002", res.Tag.Source)
+			assert.Equal(t, 7, res.Tag.LineCount)
+			assert.Equal(t, orig.Time, res.Tag.Time)
+			assert.Equal(t, orig.Group, res.Tag.Group)
+		}
+	}
+	assert.Equal(t, orig, *r.Tag, "unchanged")
+}
+
+func TestParseRaw_tagBlock(t *testing.T) {
+	line := tagBlockStr + gprmcStr
+	r, err := ParseRaw([]byte(line))
+	assert.Nil(t, err)
+	assert.Equal(t, "GPRMC", r.TypeName)
+	if assert.NotNil(t, r.Tag) {
+		assert.Equal(t, "GP0001", r.Tag.Source)
+	}
+	assert.Equal(t, tagBlockStr, r.String()[:len(tagBlockStr)])
+
+	s, err := Parse([]byte(line))
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPRMC, s.Type())
+
+	_, err = ParseRaw([]byte(`\s:GP0001` + gprmcStr))
+	assert.NotNil(t, err)
+}
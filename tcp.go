@@ -0,0 +1,310 @@
+package nmea
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultPort is the IANA registered port for NMEA 0183 over TCP and UDP
+const DefaultPort = 10110
+
+// ErrServerClosed is returned by TCPServer.Serve after Close is called
+var ErrServerClosed = errors.New("server closed")
+
+// TCPServer fans out sentences to all connected clients. Each client has its own queue, so a slow
+// client does not hold up the others; a client that falls too far behind is disconnected.
+type TCPServer struct {
+	// QueueSize is the number of sentences buffered per client before it is considered too slow
+	// and dropped. Defaults to 64.
+	QueueSize int
+
+	// WriteTimeout, if set, is the maximum time to wait for a single write to a client before
+	// it is dropped.
+	WriteTimeout time.Duration
+
+	mx        sync.Mutex
+	clients   map[*tcpServerClient]struct{}
+	listeners map[net.Listener]struct{}
+	closed    bool
+}
+
+type tcpServerClient struct {
+	conn  net.Conn
+	queue chan string
+	once  sync.Once
+}
+
+func (c *tcpServerClient) close() {
+	c.once.Do(func() {
+		close(c.queue)
+		c.conn.Close()
+	})
+}
+
+// Serve will accept connections on l until it returns an error or the server is closed.
+// Data received from clients is discarded.
+func (s *TCPServer) Serve(l net.Listener) error {
+	s.mx.Lock()
+	if s.closed {
+		s.mx.Unlock()
+		return ErrServerClosed
+	}
+	if s.listeners == nil {
+		s.listeners = make(map[net.Listener]struct{})
+	}
+	s.listeners[l] = struct{}{}
+	s.mx.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.mx.Lock()
+			closed := s.closed
+			delete(s.listeners, l)
+			s.mx.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+		s.add(conn)
+	}
+}
+
+func (s *TCPServer) add(conn net.Conn) {
+	size := s.QueueSize
+	if size <= 0 {
+		size = 64
+	}
+	c := &tcpServerClient{conn: conn, queue: make(chan string, size)}
+
+	s.mx.Lock()
+	if s.closed {
+		s.mx.Unlock()
+		conn.Close()
+		return
+	}
+	if s.clients == nil {
+		s.clients = make(map[*tcpServerClient]struct{})
+	}
+	s.clients[c] = struct{}{}
+	s.mx.Unlock()
+
+	go s.writeLoop(c)
+	go func() {
+		// discard anything sent by the client, and notice when it disconnects
+		io.Copy(io.Discard, conn)
+		s.remove(c)
+	}()
+}
+
+func (s *TCPServer) remove(c *tcpServerClient) {
+	s.mx.Lock()
+	delete(s.clients, c)
+	s.mx.Unlock()
+	c.close()
+}
+
+func (s *TCPServer) writeLoop(c *tcpServerClient) {
+	for line := range c.queue {
+		if s.WriteTimeout > 0 {
+			c.conn.SetWriteDeadline(time.Now().Add(s.WriteTimeout))
+		}
+		_, err := io.WriteString(c.conn, line)
+		if err != nil {
+			s.remove(c)
+			return
+		}
+	}
+}
+
+// WriteSentence will queue the sentence for all connected clients without blocking.
+// Clients whose queue is full are disconnected.
+func (s *TCPServer) WriteSentence(sen Sentence) error {
+	line := sen.String() + "\r\n"
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.closed {
+		return ErrServerClosed
+	}
+	for c := range s.clients {
+		select {
+		case c.queue <- line:
+		default:
+			delete(s.clients, c)
+			c.close()
+		}
+	}
+	return nil
+}
+
+// Clients will return the number of connected clients
+func (s *TCPServer) Clients() int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return len(s.clients)
+}
+
+// Close will close all listeners and disconnect all clients
+func (s *TCPServer) Close() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.closed = true
+	for l := range s.listeners {
+		l.Close()
+	}
+	for c := range s.clients {
+		delete(s.clients, c)
+		c.close()
+	}
+	return nil
+}
+
+// TCPClient reads sentences from a TCP server, such as a serial-to-Ethernet gateway, reconnecting
+// with exponential backoff whenever the connection fails.
+type TCPClient struct {
+	Addr string // address to connect to
+
+	MinBackoff time.Duration // delay before the first reconnect attempt, defaults to 100ms
+	MaxBackoff time.Duration // maximum delay between reconnect attempts, defaults to 30s
+
+	// Dial, if set, is used to connect instead of net.Dial
+	Dial func(network, addr string) (net.Conn, error)
+
+	mx      sync.Mutex
+	conn    net.Conn
+	r       *Reader
+	closed  bool
+	closing chan struct{}
+	backoff time.Duration
+}
+
+// NewTCPClient will return a TCPClient for addr. The connection is made on the first read.
+func NewTCPClient(addr string) *TCPClient {
+	return &TCPClient{Addr: addr}
+}
+
+func (c *TCPClient) init() {
+	if c.closing == nil {
+		c.closing = make(chan struct{})
+	}
+}
+
+// ReadSentence will return the next sentence from the server, connecting or reconnecting as needed.
+// Sentences of an unknown type are returned as *Raw. If a line can not be parsed a *LineError is
+// returned and reading may continue. After Close, io.EOF is returned.
+func (c *TCPClient) ReadSentence() (Sentence, error) {
+	for {
+		r, err := c.reader()
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := r.ReadSentence()
+		if _, ok := err.(*LineError); ok || err == nil {
+			// the connection is delivering data, so reconnect quickly if it drops
+			c.mx.Lock()
+			if c.r == r {
+				c.backoff = 0
+			}
+			c.mx.Unlock()
+			return s, err
+		}
+
+		// connection failed, drop it and reconnect after a delay, so a server that
+		// accepts and immediately closes connections is not retried in a tight loop
+		c.mx.Lock()
+		if c.r == r {
+			c.conn.Close()
+			c.conn, c.r = nil, nil
+			c.backoff = c.nextBackoff()
+			wait, closing := c.backoff, c.closing
+			c.mx.Unlock()
+			select {
+			case <-time.After(wait):
+			case <-closing:
+			}
+			continue
+		}
+		c.mx.Unlock()
+	}
+}
+
+// reader will return the reader for the current connection, connecting with backoff if needed
+func (c *TCPClient) reader() (*Reader, error) {
+	c.mx.Lock()
+	c.init()
+	closing := c.closing
+	for c.r == nil {
+		if c.closed {
+			c.mx.Unlock()
+			return nil, io.EOF
+		}
+		c.mx.Unlock()
+
+		dial := c.Dial
+		if dial == nil {
+			dial = net.Dial
+		}
+		conn, err := dial("tcp", c.Addr)
+
+		c.mx.Lock()
+		if err == nil {
+			if c.closed {
+				conn.Close()
+				continue
+			}
+			c.conn, c.r = conn, NewReader(conn)
+			break
+		}
+
+		c.backoff = c.nextBackoff()
+		wait := c.backoff
+		c.mx.Unlock()
+		select {
+		case <-time.After(wait):
+		case <-closing:
+		}
+		c.mx.Lock()
+	}
+	r := c.r
+	c.mx.Unlock()
+	return r, nil
+}
+
+func (c *TCPClient) nextBackoff() time.Duration {
+	min, max := c.MinBackoff, c.MaxBackoff
+	if min <= 0 {
+		min = 100 * time.Millisecond
+	}
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	if c.backoff < min {
+		return min
+	}
+	if c.backoff*2 > max {
+		return max
+	}
+	return c.backoff * 2
+}
+
+// Close will disconnect from the server and stop reconnecting
+func (c *TCPClient) Close() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.init()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	close(c.closing)
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	return nil
+}
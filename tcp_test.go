@@ -0,0 +1,172 @@
+package nmea
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestTCPServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var srv TCPServer
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(l) }()
+
+	conns := make([]net.Conn, 2)
+	for i := range conns {
+		conns[i], err = net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conns[i].Close()
+	}
+	waitFor(t, func() bool { return srv.Clients() == 2 })
+
+	assert.Nil(t, srv.WriteSentence(HDT{Talker: "HE", Heading: 91.5}))
+	for _, c := range conns {
+		c.SetReadDeadline(time.Now().Add(5 * time.Second))
+		line, err := bufio.NewReader(c).ReadString('\n')
+		assert.Nil(t, err)
+		assert.Equal(t, HDT{Talker: "HE", Heading: 91.5}.String()+"\r\n", line)
+	}
+
+	conns[0].Close()
+	waitFor(t, func() bool { return srv.Clients() == 1 })
+
+	srv.Close()
+	assert.Equal(t, ErrServerClosed, <-done)
+	assert.Equal(t, 0, srv.Clients())
+	assert.Equal(t, ErrServerClosed, srv.WriteSentence(HDT{}))
+}
+
+func TestTCPServer_slowClient(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := TCPServer{QueueSize: 1}
+	defer srv.Close()
+	go srv.Serve(l)
+
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	waitFor(t, func() bool { return srv.Clients() == 1 })
+
+	// the client never reads, eventually its queue fills and it is dropped
+	for i := 0; i < 1e6 && srv.Clients() > 0; i++ {
+		srv.WriteSentence(HDT{Talker: "HE", Heading: 91.5})
+	}
+	assert.Equal(t, 0, srv.Clients())
+}
+
+func TestTCPClient(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			io.WriteString(c, gprmcStr+"\r\n$GPXXX*00\r\n")
+			c.Close()
+		}
+	}()
+
+	c := NewTCPClient(l.Addr().String())
+	c.MinBackoff = time.Millisecond
+
+	// reconnects after each disconnect
+	for i := 0; i < 2; i++ {
+		s, err := c.ReadSentence()
+		assert.Nil(t, err)
+		assert.Equal(t, TypeGPRMC, s.Type())
+
+		_, err = c.ReadSentence()
+		assert.IsType(t, &LineError{}, err)
+	}
+
+	c.Close()
+	_, err = c.ReadSentence()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestTCPClient_backoff(t *testing.T) {
+	var attempts int
+	c := &TCPClient{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 4 * time.Millisecond,
+		Dial: func(network, addr string) (net.Conn, error) {
+			attempts++
+			return nil, errors.New("refused")
+		},
+	}
+	assert.Equal(t, time.Millisecond, c.nextBackoff())
+	c.backoff = 2 * time.Millisecond
+	assert.Equal(t, 4*time.Millisecond, c.nextBackoff())
+	c.backoff = 4 * time.Millisecond
+	assert.Equal(t, 4*time.Millisecond, c.nextBackoff())
+	c.backoff = 0
+
+	// Close interrupts a pending reconnect
+	c.MinBackoff, c.MaxBackoff = time.Hour, time.Hour
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		c.Close()
+	}()
+	_, err := c.ReadSentence()
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestTCPClient_backoffAfterDisconnect(t *testing.T) {
+	// a server that accepts and immediately closes each connection
+	var attempts int
+	c := &TCPClient{
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 40 * time.Millisecond,
+		Dial: func(network, addr string) (net.Conn, error) {
+			attempts++
+			conn, srv := net.Pipe()
+			srv.Close()
+			return conn, nil
+		},
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := c.ReadSentence()
+		done <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	c.Close()
+	assert.Equal(t, io.EOF, <-done)
+
+	// 10+20+40+40+40... ms between attempts
+	assert.True(t, attempts >= 2 && attempts <= 10, "attempts: %d", attempts)
+}
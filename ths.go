@@ -0,0 +1,69 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// THSMode is the mode indicator for a THS sentence. Only Autonomous represents a valid heading
+type THSMode string
+
+// Mode indicators for THS
+const (
+	THSModeAutonomous THSMode = "A"
+	THSModeEstimated  THSMode = "E" // dead reckoning
+	THSModeManual     THSMode = "M" // manual input
+	THSModeSimulator  THSMode = "S"
+	THSModeNotValid   THSMode = "V"
+)
+
+// THS reports the true heading of the vessel along with a mode indicator, replacing HDT
+type THS struct {
+	Talker  string  `csv:"talker"`           // talker ID, defaults to HE (gyro, north seeking) when serializing
+	Heading float64 `csv:"heading_true_deg"` // heading in degrees True
+	Mode    THSMode `csv:"mode"`             // mode indicator
+}
+
+// Type returns TypeTHS to fulfill the Sentence interface
+func (h THS) Type() Type {
+	return TypeTHS
+}
+
+// String will provide a NMEA formatted string
+func (h THS) String() string {
+	return Raw{
+		TypeName: typeName(h.Talker, "HE", TypeTHS),
+		Fields: []string{
+			strconv.FormatFloat(h.Heading, 'f', -1, 64),
+			string(h.Mode),
+		},
+	}.String()
+}
+
+// Parse will parse THS data from a raw sentence struct
+func (h *THS) Parse(r *Raw) error {
+	var err error
+	h.Talker, err = parseTalker(r, TypeTHS)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 2 {
+		return fmt.Errorf("not enough fields, need at least 2")
+	}
+
+	h.Heading, err = parseFieldFloat(r.Fields[0], "Heading")
+	if err != nil {
+		return err
+	}
+
+	switch THSMode(r.Fields[1]) {
+	case THSModeAutonomous, THSModeEstimated, THSModeManual, THSModeSimulator, THSModeNotValid:
+		h.Mode = THSMode(r.Fields[1])
+	case THSMode(""):
+		h.Mode = THSModeNotValid
+	default:
+		return fmt.Errorf("invalid mode indicator: %s", r.Fields[1])
+	}
+
+	return nil
+}
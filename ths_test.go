@@ -0,0 +1,31 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const thsStr = "$INTHS,123.4,A*23"
+
+func TestTHS_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(thsStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := new(THS)
+	err = h.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeTHS, h.Type(), "type")
+	assert.Equal(t, "IN", h.Talker, "talker")
+	assert.Equal(t, 123.4, h.Heading, "heading")
+	assert.Equal(t, THSModeAutonomous, h.Mode, "mode")
+}
+
+func TestTHS_String(t *testing.T) {
+	str := THS{Heading: 5.25, Mode: THSModeEstimated}.String()
+
+	assert.Equal(t, "$HETHS,5.25,E*1B", str)
+}
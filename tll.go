@@ -0,0 +1,90 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+// TLL reports the position of a target tracked by radar
+type TLL struct {
+	Talker    string    `csv:"talker"` // talker ID, defaults to RA (radar) when serializing
+	Number    int       `csv:"number"` // target number, 00 to 99
+	Latitude  Coord     `csv:"latitude_deg"`
+	Longitude Coord     `csv:"longitude_deg"`
+	Name      string    `csv:"name"`      // target name
+	Time      time.Time `csv:"time"`      // time of the data
+	Status    TTMStatus `csv:"status"`    // tracking status
+	Reference bool      `csv:"reference"` // true if this is a reference target
+}
+
+// Type returns TypeTLL to fulfill the Sentence interface
+func (l TLL) Type() Type {
+	return TypeTLL
+}
+
+// String will provide a NMEA formatted string. Date information from the Time field is ignored.
+func (l TLL) String() string {
+	ref := ""
+	if l.Reference {
+		ref = "R"
+	}
+	return Raw{
+		TypeName: typeName(l.Talker, "RA", TypeTLL),
+		Fields: []string{
+			fmt.Sprintf("%02d", l.Number),
+			l.Latitude.String(),
+			l.Latitude.Direction().LatString(),
+			l.Longitude.LonString(),
+			l.Longitude.Direction().LongString(),
+			l.Name,
+			l.Time.Format(timeFormat),
+			string(l.Status),
+			ref,
+		},
+	}.String()
+}
+
+// Parse will parse TLL data from a raw sentence struct
+func (l *TLL) Parse(r *Raw) error {
+	var err error
+	l.Talker, err = parseTalker(r, TypeTLL)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 9 {
+		return fmt.Errorf("not enough fields, need at least 9")
+	}
+
+	l.Number, err = parseFieldInt(r.Fields[0], "Number")
+	if err != nil {
+		return err
+	}
+
+	l.Latitude, err = parseFieldCoord(r.Fields[1], r.Fields[2], "latitude")
+	if err != nil {
+		return err
+	}
+	l.Longitude, err = parseFieldCoord(r.Fields[3], r.Fields[4], "longitude")
+	if err != nil {
+		return err
+	}
+
+	l.Name = r.Fields[5]
+
+	if r.Fields[6] != "" {
+		l.Time, err = time.ParseInLocation(timeFormat, r.Fields[6], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	} else {
+		l.Time = time.Time{}
+	}
+
+	l.Status, err = parseFieldTargetStatus(r.Fields[7])
+	if err != nil {
+		return err
+	}
+	l.Reference = r.Fields[8] == "R"
+
+	return nil
+}
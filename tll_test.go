@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const tllStr = "$RATLL,01,4917.24,N,12309.57,W,TGT1,225444,T,*66"
+
+func TestTLL_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(tllStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := new(TLL)
+	err = l.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeTLL, l.Type(), "type")
+	assert.Equal(t, 1, l.Number, "number")
+	assert.InDelta(t, 49.287333, float64(l.Latitude), epsilon, "latitude")
+	assert.Equal(t, "TGT1", l.Name, "name")
+	assert.Equal(t, "225444", l.Time.Format(timeFormat), "timestamp")
+	assert.Equal(t, TTMStatusTracking, l.Status, "status")
+	assert.False(t, l.Reference, "reference")
+}
+
+func TestTLL_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := TLL{
+		Number:    2,
+		Latitude:  Coord(-45.51),
+		Longitude: Coord(45.26),
+		Name:      "BUOY",
+		Time:      tm,
+		Status:    TTMStatusTracking,
+		Reference: true,
+	}.String()
+
+	assert.Equal(t, "$RATLL,02,4530.6,S,04515.6,E,BUOY,040506,T,R*48", str)
+}
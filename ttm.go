@@ -0,0 +1,181 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TTMStatus is the tracking status of a radar target
+type TTMStatus string
+
+// Target statuses for TTM and TLL
+const (
+	TTMStatusLost     TTMStatus = "L" // target lost, and no longer tracked
+	TTMStatusQuery    TTMStatus = "Q" // target is being acquired
+	TTMStatusTracking TTMStatus = "T"
+)
+
+// TTM reports a target tracked by radar, relative to own ship
+type TTM struct {
+	Talker          string        `csv:"talker"`           // talker ID, defaults to RA (radar) when serializing
+	Number          int           `csv:"number"`           // target number, 00 to 99
+	Distance        float64       `csv:"distance_nm"`      // distance to the target in nautical miles
+	Bearing         float64       `csv:"bearing_deg"`      // bearing to the target in degrees
+	BearingRelative bool          `csv:"bearing_relative"` // true if Bearing is relative to own heading, otherwise True
+	Speed           float64       `csv:"speed_kn"`         // target speed in knots
+	Course          float64       `csv:"course_deg"`       // target course in degrees
+	CourseRelative  bool          `csv:"course_relative"`  // true if Speed and Course are relative to own ship, otherwise True
+	CPA             float64       `csv:"cpa_nm"`           // distance at the closest point of approach in nautical miles
+	TCPA            time.Duration `csv:"tcpa_s"`           // time to the closest point of approach, negative if it has passed
+	Name            string        `csv:"name"`             // target name
+	Status          TTMStatus     `csv:"status"`           // tracking status
+	Reference       bool          `csv:"reference"`        // true if this is a reference target
+	Time            time.Time     `csv:"time"`             // time of the data
+	AutoAcquisition bool          `csv:"auto_acquisition"` // true if the target was acquired automatically, false for manual
+}
+
+// Type returns TypeTTM to fulfill the Sentence interface
+func (m TTM) Type() Type {
+	return TypeTTM
+}
+
+// String will provide a NMEA formatted string. Distances and speeds are written in nautical miles and knots
+func (m TTM) String() string {
+	ref := ""
+	if m.Reference {
+		ref = "R"
+	}
+	acq := "M"
+	if m.AutoAcquisition {
+		acq = "A"
+	}
+	return Raw{
+		TypeName: typeName(m.Talker, "RA", TypeTTM),
+		Fields: []string{
+			fmt.Sprintf("%02d", m.Number),
+			strconv.FormatFloat(m.Distance, 'f', -1, 64),
+			strconv.FormatFloat(m.Bearing, 'f', -1, 64),
+			formatFieldRelative(m.BearingRelative),
+			strconv.FormatFloat(m.Speed, 'f', -1, 64),
+			strconv.FormatFloat(m.Course, 'f', -1, 64),
+			formatFieldRelative(m.CourseRelative),
+			strconv.FormatFloat(m.CPA, 'f', -1, 64),
+			strconv.FormatFloat(m.TCPA.Minutes(), 'f', -1, 64),
+			string(SpeedUnitKnots),
+			m.Name,
+			string(m.Status),
+			ref,
+			m.Time.Format(timeFormat),
+			acq,
+		},
+	}.String()
+}
+
+// Parse will parse TTM data from a raw sentence struct. Distances and speeds are converted to nautical miles and knots
+func (m *TTM) Parse(r *Raw) error {
+	var err error
+	m.Talker, err = parseTalker(r, TypeTTM)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 13 {
+		return fmt.Errorf("not enough fields, need at least 13")
+	}
+
+	m.Number, err = parseFieldInt(r.Fields[0], "Number")
+	if err != nil {
+		return err
+	}
+
+	unit, err := parseDistanceUnit(r.Fields[9], "Distance")
+	if err != nil {
+		return err
+	}
+
+	m.Distance, err = parseFieldFloat(r.Fields[1], "Distance")
+	if err != nil {
+		return err
+	}
+	m.Distance = toNauticalMiles(m.Distance, unit)
+	m.Bearing, err = parseFieldFloat(r.Fields[2], "Bearing")
+	if err != nil {
+		return err
+	}
+	m.BearingRelative, err = parseFieldRelative(r.Fields[3], "Bearing")
+	if err != nil {
+		return err
+	}
+
+	m.Speed, err = parseFieldFloat(r.Fields[4], "Speed")
+	if err != nil {
+		return err
+	}
+	m.Speed = ConvertSpeed(m.Speed, unit, SpeedUnitKnots)
+	m.Course, err = parseFieldFloat(r.Fields[5], "Course")
+	if err != nil {
+		return err
+	}
+	m.CourseRelative, err = parseFieldRelative(r.Fields[6], "Course")
+	if err != nil {
+		return err
+	}
+
+	m.CPA, err = parseFieldFloat(r.Fields[7], "CPA")
+	if err != nil {
+		return err
+	}
+	m.CPA = toNauticalMiles(m.CPA, unit)
+	tcpa, err := parseFieldFloat(r.Fields[8], "TCPA")
+	if err != nil {
+		return err
+	}
+	m.TCPA = time.Duration(tcpa * float64(time.Minute))
+
+	m.Name = r.Fields[10]
+	m.Status, err = parseFieldTargetStatus(r.Fields[11])
+	if err != nil {
+		return err
+	}
+	m.Reference = r.Fields[12] == "R"
+
+	m.Time = time.Time{}
+	if len(r.Fields) >= 14 && r.Fields[13] != "" {
+		m.Time, err = time.ParseInLocation(timeFormat, r.Fields[13], time.UTC)
+		if err != nil {
+			return fmt.Errorf("parse time: %s", err)
+		}
+	}
+
+	m.AutoAcquisition = len(r.Fields) >= 15 && r.Fields[14] == "A"
+	return nil
+}
+
+func parseFieldRelative(val, name string) (bool, error) {
+	switch val {
+	case "T", "":
+		return false, nil
+	case "R":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown reference for %s: %s", name, val)
+	}
+}
+
+func formatFieldRelative(rel bool) string {
+	if rel {
+		return "R"
+	}
+	return "T"
+}
+
+func parseFieldTargetStatus(val string) (TTMStatus, error) {
+	switch TTMStatus(val) {
+	case TTMStatusLost, TTMStatusQuery, TTMStatusTracking:
+		return TTMStatus(val), nil
+	case TTMStatus(""):
+		return TTMStatusTracking, nil
+	default:
+		return "", fmt.Errorf("invalid target status: %s", val)
+	}
+}
@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ttmStr = "$RATTM,11,11.4,13.6,T,7.0,20.0,T,0.5,-2.3,N,TGT11,T,,225444,A*73"
+
+func TestTTM_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(ttmStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := new(TTM)
+	err = m.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeTTM, m.Type(), "type")
+	assert.Equal(t, 11, m.Number, "number")
+	assert.Equal(t, 11.4, m.Distance, "distance")
+	assert.Equal(t, 13.6, m.Bearing, "bearing")
+	assert.False(t, m.BearingRelative, "bearing relative")
+	assert.Equal(t, 7.0, m.Speed, "speed")
+	assert.Equal(t, 20.0, m.Course, "course")
+	assert.False(t, m.CourseRelative, "course relative")
+	assert.Equal(t, 0.5, m.CPA, "CPA")
+	assert.Equal(t, -138*time.Second, m.TCPA, "TCPA")
+	assert.Equal(t, "TGT11", m.Name, "name")
+	assert.Equal(t, TTMStatusTracking, m.Status, "status")
+	assert.False(t, m.Reference, "reference")
+	assert.Equal(t, "225444", m.Time.Format(timeFormat), "timestamp")
+	assert.True(t, m.AutoAcquisition, "auto acquisition")
+}
+
+func TestTTM_String(t *testing.T) {
+	tm, err := time.ParseInLocation("1/2/06 15:04:05", "1/2/03 4:05:06", time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	str := TTM{
+		Number:          5,
+		Distance:        2.5,
+		Bearing:         90,
+		BearingRelative: true,
+		Speed:           10,
+		Course:          180,
+		CPA:             0.25,
+		TCPA:            12*time.Minute + 30*time.Second,
+		Name:            "SHIP",
+		Status:          TTMStatusQuery,
+		Reference:       true,
+		Time:            tm,
+	}.String()
+
+	assert.Equal(t, "$RATTM,05,2.5,90,R,10,180,T,0.25,12.5,N,SHIP,Q,R,040506,M*6D", str)
+}
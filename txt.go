@@ -0,0 +1,90 @@
+package nmea
+
+import "fmt"
+
+// TXT reports a short text message, such as antenna status or firmware version. Longer
+// messages are split across multiple sentences with the same ID.
+type TXT struct {
+	Talker string `csv:"talker"` // talker ID, defaults to GP when serializing
+	Total  int    `csv:"total"`  // total number of sentences in the message, 01 to 99
+	Number int    `csv:"number"` // number of this sentence
+	ID     int    `csv:"id"`     // text identifier, used to group multi-sentence messages
+	Text   string `csv:"text"`   // message text
+}
+
+// Type returns TypeTXT to fulfill the Sentence interface
+func (t TXT) Type() Type {
+	return TypeTXT
+}
+
+// String will provide a NMEA formatted string
+func (t TXT) String() string {
+	return Raw{
+		TypeName: typeName(t.Talker, "GP", TypeTXT),
+		Fields: []string{
+			fmt.Sprintf("%02d", t.Total),
+			fmt.Sprintf("%02d", t.Number),
+			fmt.Sprintf("%02d", t.ID),
+			t.Text,
+		},
+	}.String()
+}
+
+// Parse will parse TXT data from a raw sentence struct
+func (t *TXT) Parse(r *Raw) error {
+	var err error
+	t.Talker, err = parseTalker(r, TypeTXT)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 4 {
+		return fmt.Errorf("not enough fields, need at least 4")
+	}
+
+	t.Total, err = parseFieldInt(r.Fields[0], "Total")
+	if err != nil {
+		return err
+	}
+	t.Number, err = parseFieldInt(r.Fields[1], "Number")
+	if err != nil {
+		return err
+	}
+	t.ID, err = parseFieldInt(r.Fields[2], "ID")
+	if err != nil {
+		return err
+	}
+
+	t.Text = r.Fields[3]
+	return nil
+}
+
+// TXTAssembler will reassemble multi-sentence TXT messages
+type TXTAssembler struct {
+	pending map[string]*TXT
+}
+
+// Add will add a TXT sentence to the assembler. The full message text is returned, with ok set to true,
+// when the last sentence of the message has been added.
+func (a *TXTAssembler) Add(t TXT) (text string, ok bool, err error) {
+	if a.pending == nil {
+		a.pending = make(map[string]*TXT)
+	}
+
+	key := fmt.Sprintf("%s%d", t.Talker, t.ID)
+	p := a.pending[key]
+	if t.Number <= 1 {
+		p = &TXT{Total: t.Total}
+		a.pending[key] = p
+	} else if p == nil || t.Number != p.Number+1 || t.Total != p.Total {
+		delete(a.pending, key)
+		return "", false, fmt.Errorf("unexpected TXT sentence %d of %d for ID %d", t.Number, t.Total, t.ID)
+	}
+	p.Number = t.Number
+	p.Text += t.Text
+
+	if p.Number < p.Total {
+		return "", false, nil
+	}
+	delete(a.pending, key)
+	return p.Text, true, nil
+}
@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const txtStr = "$GPTXT,01,01,02,ANTSTATUS=OK*3B"
+
+func TestTXT_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(txtStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := new(TXT)
+	err = x.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeTXT, x.Type(), "type")
+	assert.Equal(t, 1, x.Total, "total")
+	assert.Equal(t, 1, x.Number, "number")
+	assert.Equal(t, 2, x.ID, "ID")
+	assert.Equal(t, "ANTSTATUS=OK", x.Text, "text")
+
+	r, err = ParseRaw([]byte("$GPTXT,01,01,01,A^2CB^2AC*0C"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = x.Parse(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "A,B*C", x.Text, "escaped text")
+}
+
+func TestTXT_String(t *testing.T) {
+	str := TXT{Talker: "GN", Total: 1, Number: 1, ID: 2, Text: "u-blox AG - www.u-blox.com"}.String()
+	assert.Equal(t, "$GNTXT,01,01,02,u-blox AG - www.u-blox.com*4E", str)
+
+	str = TXT{Total: 1, Number: 1, ID: 1, Text: "A,B*C"}.String()
+	assert.Equal(t, "$GPTXT,01,01,01,A^2CB^2AC*0C", str)
+}
+
+func TestTXTAssembler_Add(t *testing.T) {
+	var a TXTAssembler
+
+	_, ok, err := a.Add(TXT{Talker: "GP", Total: 2, Number: 1, ID: 5, Text: "HW UBX-M8030 "})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	text, ok, err := a.Add(TXT{Talker: "GP", Total: 1, Number: 1, ID: 6, Text: "ANTSTATUS=OK"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "ANTSTATUS=OK", text)
+
+	text, ok, err = a.Add(TXT{Talker: "GP", Total: 2, Number: 2, ID: 5, Text: "00080000"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "HW UBX-M8030 00080000", text)
+
+	_, _, err = a.Add(TXT{Talker: "GP", Total: 2, Number: 2, ID: 5, Text: "00080000"})
+	assert.NotNil(t, err)
+}
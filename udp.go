@@ -0,0 +1,59 @@
+package nmea
+
+import "net"
+
+// UDPListener reads plain NMEA 0183 sentences from datagrams, as sent by many gateways.
+// A datagram may carry one or more lines.
+type UDPListener struct {
+	conn    net.PacketConn
+	buf     []byte
+	pending [][]byte
+}
+
+// NewUDPListener will return a UDPListener reading from conn, such as one returned by
+// net.ListenPacket("udp4", ":10110")
+func NewUDPListener(conn net.PacketConn) *UDPListener {
+	return &UDPListener{conn: conn, buf: make([]byte, maxDatagramSize)}
+}
+
+// ReadSentence will return the next sentence. Sentences of an unknown type are returned as *Raw.
+// If a line can not be parsed a *LineError is returned and reading may continue.
+func (l *UDPListener) ReadSentence() (Sentence, error) {
+	for len(l.pending) == 0 {
+		n, _, err := l.conn.ReadFrom(l.buf)
+		if err != nil {
+			return nil, err
+		}
+		l.pending = splitLines(l.buf[:n])
+	}
+
+	line := l.pending[0]
+	l.pending = l.pending[1:]
+	raw, err := ParseRaw(line)
+	if err != nil {
+		return nil, &LineError{Line: string(line), Err: err}
+	}
+	s, err := parseRawSentence(raw)
+	if err != nil {
+		return nil, &LineError{Line: string(line), Err: err}
+	}
+	return s, nil
+}
+
+// UDPSender sends sentences as datagrams, one sentence per datagram
+type UDPSender struct {
+	conn net.PacketConn
+	addr net.Addr
+}
+
+// NewUDPSender will return a UDPSender sending to addr over conn. To broadcast, addr should be
+// a broadcast address such as 255.255.255.255:10110.
+func NewUDPSender(conn net.PacketConn, addr net.Addr) *UDPSender {
+	return &UDPSender{conn: conn, addr: addr}
+}
+
+// WriteSentence will send the sentence, followed by <CR><LF>, in its own datagram
+func (s *UDPSender) WriteSentence(sen Sentence) error {
+	_, err := s.conn.WriteTo([]byte(sen.String()+"\r\n"), s.addr)
+	return err
+}
@@ -0,0 +1,120 @@
+package nmea
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// IEC 61162-450 multicast groups, by transmission group
+const (
+	UDPGroupMISC = "239.192.0.1:60001" // miscellaneous, non-navigational
+	UDPGroupTGTD = "239.192.0.2:60002" // target data (AIS and radar)
+	UDPGroupSATD = "239.192.0.3:60003" // high update rate, e.g. ship heading and attitude
+	UDPGroupNAVD = "239.192.0.4:60004" // navigational output other than TGTD and SATD
+	UDPGroupVDRD = "239.192.0.5:60005" // voyage data recorder
+	UDPGroupRCOM = "239.192.0.6:60006" // radio communication equipment
+	UDPGroupTIME = "239.192.0.7:60007" // time sources
+	UDPGroupPROP = "239.192.0.8:60008" // proprietary and user defined
+)
+
+// udpHeader is the token that begins every IEC 61162-450 sentence datagram
+const udpHeader = "UdPbC\x00"
+
+// maxDatagramSize is the largest datagram accepted by a UDPReader
+const maxDatagramSize = 1472
+
+// ErrUDPHeader is returned when a datagram does not begin with the IEC 61162-450 header
+var ErrUDPHeader = errors.New("missing IEC 61162-450 header")
+
+// ListenUDPGroup will join one of the IEC 61162-450 multicast groups (e.g. UDPGroupNAVD) on the given interface.
+// If ifi is nil, the system-assigned multicast interface is used.
+func ListenUDPGroup(group string, ifi *net.Interface) (*net.UDPConn, error) {
+	addr, err := net.ResolveUDPAddr("udp4", group)
+	if err != nil {
+		return nil, err
+	}
+	return net.ListenMulticastUDP("udp4", ifi, addr)
+}
+
+// UDPReader reads sentences framed according to IEC 61162-450 from a packet connection.
+// Each sentence carries a TAG block identifying its source.
+type UDPReader struct {
+	conn    net.PacketConn
+	buf     []byte
+	pending [][]byte
+	addr    net.Addr
+}
+
+// NewUDPReader will return a UDPReader reading from conn
+func NewUDPReader(conn net.PacketConn) *UDPReader {
+	return &UDPReader{conn: conn, buf: make([]byte, maxDatagramSize)}
+}
+
+// ReadRaw will return the next sentence as a Raw struct, along with the address it was received from.
+// The TAG block of the sentence is available from the Tag field. Datagrams without the
+// IEC 61162-450 header are skipped.
+func (r *UDPReader) ReadRaw() (*Raw, net.Addr, error) {
+	for len(r.pending) == 0 {
+		n, addr, err := r.conn.ReadFrom(r.buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.HasPrefix(r.buf[:n], []byte(udpHeader)) {
+			continue
+		}
+		r.pending = splitLines(r.buf[len(udpHeader):n])
+		r.addr = addr
+	}
+
+	line := r.pending[0]
+	r.pending = r.pending[1:]
+	raw, err := ParseRaw(line)
+	return raw, r.addr, err
+}
+
+// ReadSentence will return the next sentence. Sentences of an unknown type are returned as *Raw.
+// Use ReadRaw for access to the TAG block.
+func (r *UDPReader) ReadSentence() (Sentence, error) {
+	raw, _, err := r.ReadRaw()
+	if err != nil {
+		return nil, err
+	}
+	return parseRawSentence(raw)
+}
+
+// UDPWriter writes sentences framed according to IEC 61162-450 to a packet connection
+type UDPWriter struct {
+	conn net.PacketConn
+	addr net.Addr
+
+	// Source is the source identifier sent with each sentence, made of a two character
+	// talker ID and a four digit instance number (e.g. GP0001)
+	Source string
+
+	// Destination, if set, is the destination identifier sent with each sentence
+	Destination string
+
+	lineCount int
+}
+
+// NewUDPWriter will return a UDPWriter sending to addr (e.g. an IEC 61162-450 multicast group) over conn
+func NewUDPWriter(conn net.PacketConn, addr net.Addr, source string) *UDPWriter {
+	return &UDPWriter{conn: conn, addr: addr, Source: source}
+}
+
+// WriteSentence will send the sentence in its own datagram, with a TAG block carrying the source,
+// destination and line count.
+func (w *UDPWriter) WriteSentence(s Sentence) error {
+	if len(w.Source) != 6 {
+		return fmt.Errorf("invalid source identifier '%s'", w.Source)
+	}
+
+	// line count cycles from 1 to 999
+	w.lineCount = w.lineCount%999 + 1
+	tag := TagBlock{Source: w.Source, Destination: w.Destination, LineCount: w.lineCount}
+
+	_, err := w.conn.WriteTo([]byte(udpHeader+tag.Wrap(s)+"\r\n"), w.addr)
+	return err
+}
@@ -0,0 +1,98 @@
+package nmea
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUDPWriter_loopback(t *testing.T) {
+	rc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	wc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wc.Close()
+
+	w := NewUDPWriter(wc, rc.LocalAddr(), "GP0001")
+	r := NewUDPReader(rc)
+	rc.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	assert.Nil(t, w.WriteSentence(HDT{Talker: "HE", Heading: 91.5}))
+	assert.Nil(t, w.WriteSentence(Raw{TypeName: "PXYZ", Fields: []string{"1"}}))
+
+	raw, _, err := r.ReadRaw()
+	assert.Nil(t, err)
+	assert.Equal(t, "HEHDT", raw.TypeName)
+	if assert.NotNil(t, raw.Tag) {
+		assert.Equal(t, "GP0001", raw.Tag.Source)
+		assert.Equal(t, 1, raw.Tag.LineCount)
+	}
+
+	s, err := r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, Type("PXYZ"), s.Type())
+
+	// datagrams without the header are ignored
+	_, err = wc.WriteTo([]byte(gprmcStr+"\r\n"), rc.LocalAddr())
+	assert.Nil(t, err)
+	_, err = wc.WriteTo([]byte(udpHeader+`\s:GP0002,n:7*13\`+gprmcStr+"\r\n"+`\s:GP0002,n:8*1C\`+gpggaStr+"\r\n"), rc.LocalAddr())
+	assert.Nil(t, err)
+
+	raw, addr, err := r.ReadRaw()
+	assert.Nil(t, err)
+	assert.Equal(t, wc.LocalAddr().String(), addr.String())
+	assert.Equal(t, "GPRMC", raw.TypeName)
+	assert.Equal(t, 7, raw.Tag.LineCount)
+
+	s, err = r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPGGA, s.Type())
+
+	// forwarded sentences keep a single TAG block, with the writer's source
+	raw.Tag.Time = time.Unix(1577836800, 0)
+	assert.Nil(t, w.WriteSentence(raw))
+	raw, _, err = r.ReadRaw()
+	assert.Nil(t, err)
+	assert.Equal(t, "GPRMC", raw.TypeName)
+	if assert.NotNil(t, raw.Tag) {
+		assert.Equal(t, "GP0001", raw.Tag.Source)
+		assert.Equal(t, 3, raw.Tag.LineCount)
+		assert.Equal(t, int64(1577836800), raw.Tag.Time.Unix())
+	}
+}
+
+func TestUDPWriter_multicast(t *testing.T) {
+	rc, err := ListenUDPGroup(UDPGroupMISC, nil)
+	if err != nil {
+		t.Skip("multicast unavailable:", err)
+	}
+	defer rc.Close()
+	wc, err := net.ListenPacket("udp4", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wc.Close()
+
+	addr, err := net.ResolveUDPAddr("udp4", UDPGroupMISC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewUDPWriter(wc, addr, "GP0001")
+	if err := w.WriteSentence(HDT{Heading: 91.5}); err != nil {
+		t.Skip("multicast unavailable:", err)
+	}
+
+	rc.SetReadDeadline(time.Now().Add(time.Second))
+	s, err := NewUDPReader(rc).ReadSentence()
+	if err != nil {
+		t.Skip("multicast unavailable:", err)
+	}
+	assert.Equal(t, TypeHDT, s.Type())
+}
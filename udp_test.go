@@ -0,0 +1,44 @@
+package nmea
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUDPSender_loopback(t *testing.T) {
+	rc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	wc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wc.Close()
+
+	w := NewUDPSender(wc, rc.LocalAddr())
+	r := NewUDPListener(rc)
+	rc.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	assert.Nil(t, w.WriteSentence(HDT{Talker: "HE", Heading: 91.5}))
+	s, err := r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, &HDT{Talker: "HE", Heading: 91.5}, s)
+
+	// multiple lines in one datagram
+	_, err = wc.WriteTo([]byte(gprmcStr+"\r\n$GPXXX*00\r\n"+gpggaStr+"\r\n"), rc.LocalAddr())
+	assert.Nil(t, err)
+
+	s, err = r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPRMC, s.Type())
+	_, err = r.ReadSentence()
+	assert.IsType(t, &LineError{}, err)
+	s, err = r.ReadSentence()
+	assert.Nil(t, err)
+	assert.Equal(t, TypeGPGGA, s.Type())
+}
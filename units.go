@@ -0,0 +1,101 @@
+package nmea
+
+import "fmt"
+
+// SpeedUnit is the unit of a speed value as it appears in a sentence
+type SpeedUnit string
+
+// Speed units
+const (
+	SpeedUnitKnots             SpeedUnit = "N"
+	SpeedUnitMetersPerSecond   SpeedUnit = "M"
+	SpeedUnitKilometersPerHour SpeedUnit = "K"
+	SpeedUnitMilesPerHour      SpeedUnit = "S" // statute miles per hour
+)
+
+// metersPerSecond will return the number of meters per second in a single unit u
+func (u SpeedUnit) metersPerSecond() float64 {
+	switch u {
+	case SpeedUnitKnots:
+		return 1852.0 / 3600
+	case SpeedUnitKilometersPerHour:
+		return 1000.0 / 3600
+	case SpeedUnitMilesPerHour:
+		return 1609.344 / 3600
+	default:
+		return 1
+	}
+}
+
+// ConvertSpeed will convert a speed value from one unit to another
+func ConvertSpeed(val float64, from, to SpeedUnit) float64 {
+	if from == to {
+		return val
+	}
+	return val * from.metersPerSecond() / to.metersPerSecond()
+}
+
+func parseSpeedUnit(val, name string) (SpeedUnit, error) {
+	switch SpeedUnit(val) {
+	case SpeedUnitKnots, SpeedUnitMetersPerSecond, SpeedUnitKilometersPerHour, SpeedUnitMilesPerHour:
+		return SpeedUnit(val), nil
+	default:
+		return "", fmt.Errorf("unknown unit for %s: %s", name, val)
+	}
+}
+
+// DepthUnit is the unit of a depth value as it appears in a sentence
+type DepthUnit string
+
+// Depth units
+const (
+	DepthUnitFeet    DepthUnit = "f"
+	DepthUnitMeters  DepthUnit = "M"
+	DepthUnitFathoms DepthUnit = "F"
+)
+
+// meters will return the number of meters in a single unit u
+func (u DepthUnit) meters() float64 {
+	switch u {
+	case DepthUnitFeet:
+		return 0.3048
+	case DepthUnitFathoms:
+		return 1.8288
+	default:
+		return 1
+	}
+}
+
+// ConvertDepth will convert a depth value from one unit to another
+func ConvertDepth(val float64, from, to DepthUnit) float64 {
+	if from == to {
+		return val
+	}
+	return val * from.meters() / to.meters()
+}
+
+func parseDepthUnit(val, name string) (DepthUnit, error) {
+	switch DepthUnit(val) {
+	case DepthUnitFeet, DepthUnitMeters, DepthUnitFathoms:
+		return DepthUnit(val), nil
+	default:
+		return "", fmt.Errorf("unknown unit for %s: %s", name, val)
+	}
+}
+
+// toNauticalMiles will convert a distance to nautical miles from the distance unit
+// corresponding to a speed unit (e.g. kilometers for SpeedUnitKilometersPerHour)
+func toNauticalMiles(val float64, unit SpeedUnit) float64 {
+	return ConvertSpeed(val, unit, SpeedUnitKnots)
+}
+
+func parseDistanceUnit(val, name string) (SpeedUnit, error) {
+	switch SpeedUnit(val) {
+	case SpeedUnitKnots, SpeedUnitKilometersPerHour, SpeedUnitMilesPerHour:
+		return SpeedUnit(val), nil
+	case "":
+		return SpeedUnitKnots, nil
+	default:
+		return "", fmt.Errorf("unknown unit for %s: %s", name, val)
+	}
+}
@@ -0,0 +1,18 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertSpeed(t *testing.T) {
+	assert.InDelta(t, 1.852, ConvertSpeed(1, SpeedUnitKnots, SpeedUnitKilometersPerHour), epsilon)
+	assert.InDelta(t, 1, ConvertSpeed(0.514444, SpeedUnitMetersPerSecond, SpeedUnitKnots), epsilon)
+	assert.InDelta(t, 1.609344, ConvertSpeed(1, SpeedUnitMilesPerHour, SpeedUnitKilometersPerHour), epsilon)
+}
+
+func TestConvertDepth(t *testing.T) {
+	assert.InDelta(t, 6, ConvertDepth(1, DepthUnitFathoms, DepthUnitFeet), epsilon)
+	assert.InDelta(t, 3.048, ConvertDepth(10, DepthUnitFeet, DepthUnitMeters), epsilon)
+}
@@ -0,0 +1,327 @@
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrOutsideUTM is returned for latitudes outside the UTM grid (south of 80°S or north of 84°N),
+// which are covered by UPS instead
+var ErrOutsideUTM = errors.New("latitude outside UTM grid")
+
+// utmK0 is the UTM scale factor on the central meridian
+const utmK0 = 0.9996
+
+// utmBands are the latitude bands from 80°S, each 8° high except X, which is 12°
+const utmBands = "CDEFGHJKLMNPQRSTUVWX"
+
+// UTM is a Universal Transverse Mercator grid position on the WGS-84 ellipsoid
+type UTM struct {
+	Zone     int     // longitude zone, 1 to 60
+	Band     byte    // latitude band, C to X; N and above are in the northern hemisphere
+	Easting  float64 // in meters, including the 500km false easting
+	Northing float64 // in meters, including the 10,000km false northing in the southern hemisphere
+}
+
+// North will return true if the position is in the northern hemisphere
+func (u UTM) North() bool {
+	return u.Band >= 'N'
+}
+
+// String will return the position as zone, band, easting and northing to the meter, e.g. "31U 448251 5411932"
+func (u UTM) String() string {
+	return fmt.Sprintf("%d%c %d %d", u.Zone, u.Band, int(math.Floor(u.Easting)), int(math.Floor(u.Northing)))
+}
+
+// utmBand will return the latitude band letter for lat
+func utmBand(lat float64) byte {
+	i := int(math.Floor(lat/8 + 10))
+	if i >= len(utmBands) {
+		// X extends to 84°N
+		i = len(utmBands) - 1
+	}
+	return utmBands[i]
+}
+
+// utmZone will return the longitude zone for lat/lon, including the exceptions for southwest
+// Norway and Svalbard
+func utmZone(lat, lon float64) int {
+	zone := int(math.Floor((lon+180)/6)) + 1
+	if zone > 60 {
+		zone = 1
+	}
+	switch {
+	case lat >= 56 && lat < 64 && lon >= 3 && lon < 12:
+		zone = 32
+	case lat >= 72 && lon >= 0 && lon < 42:
+		switch {
+		case lon < 9:
+			zone = 31
+		case lon < 21:
+			zone = 33
+		case lon < 33:
+			zone = 35
+		default:
+			zone = 37
+		}
+	}
+	return zone
+}
+
+// centralMeridian will return the longitude of the central meridian of a zone in radians
+func centralMeridian(zone int) float64 {
+	return toRadians(float64((zone-1)*6 - 180 + 3))
+}
+
+// kruger will return the constant A and the series coefficients for the Krüger transverse
+// Mercator projection to third order, accurate to within a millimeter over a zone
+func kruger() (A float64, alpha, beta [3]float64) {
+	n := WGS84F / (2 - WGS84F)
+	n2, n3 := n*n, n*n*n
+	A = WGS84A / (1 + n) * (1 + n2/4)
+	alpha = [3]float64{n/2 - 2*n2/3 + 5*n3/16, 13*n2/48 - 3*n3/5, 61 * n3 / 240}
+	beta = [3]float64{n/2 - 2*n2/3 + 37*n3/96, n2/48 + n3/15, 17 * n3 / 480}
+	return A, alpha, beta
+}
+
+// utmE is the first eccentricity of the WGS-84 ellipsoid
+var utmE = math.Sqrt(WGS84F * (2 - WGS84F))
+
+// utmConformal will return the tangent of the conformal latitude for the tangent of the latitude
+func utmConformal(tau float64) float64 {
+	sigma := math.Sinh(utmE * math.Atanh(utmE*tau/math.Sqrt(1+tau*tau)))
+	return tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+}
+
+// utmProject will return the easting and northing, without false northing, for a latitude and a
+// longitude relative to the central meridian in radians
+func utmProject(phi, lambda float64) (float64, float64) {
+	tauP := utmConformal(math.Tan(phi))
+	sinL, cosL := math.Sincos(lambda)
+	xiP := math.Atan2(tauP, cosL)
+	etaP := math.Asinh(sinL / math.Sqrt(tauP*tauP+cosL*cosL))
+
+	A, alpha, _ := kruger()
+	xi, eta := xiP, etaP
+	for j, a := range alpha {
+		k := 2 * float64(j+1)
+		xi += a * math.Sin(k*xiP) * math.Cosh(k*etaP)
+		eta += a * math.Cos(k*xiP) * math.Sinh(k*etaP)
+	}
+	return utmK0*A*eta + 500e3, utmK0 * A * xi
+}
+
+// UTMFromCoord will return the UTM position of lat/lon
+func UTMFromCoord(lat, lon Coord) (UTM, error) {
+	if lat < -80 || lat > 84 {
+		return UTM{}, ErrOutsideUTM
+	}
+	lonDeg := float64(normalizeLongitude(float64(lon)))
+	zone := utmZone(float64(lat), lonDeg)
+
+	lambda := math.Remainder(toRadians(lonDeg)-centralMeridian(zone), 2*math.Pi)
+	e, n := utmProject(toRadians(float64(lat)), lambda)
+
+	u := UTM{
+		Zone:     zone,
+		Band:     utmBand(float64(lat)),
+		Easting:  e,
+		Northing: n,
+	}
+	if lat < 0 {
+		u.Northing += 10000e3
+	}
+	return u, nil
+}
+
+// Coord will return the latitude and longitude of the position
+func (u UTM) Coord() (lat, lon Coord) {
+	A, _, beta := kruger()
+	y := u.Northing
+	if !u.North() {
+		y -= 10000e3
+	}
+	xi := y / (utmK0 * A)
+	eta := (u.Easting - 500e3) / (utmK0 * A)
+
+	xiP, etaP := xi, eta
+	for j, b := range beta {
+		k := 2 * float64(j+1)
+		xiP -= b * math.Sin(k*xi) * math.Cosh(k*eta)
+		etaP -= b * math.Cos(k*xi) * math.Sinh(k*eta)
+	}
+	sinhEta := math.Sinh(etaP)
+	sinXi, cosXi := math.Sincos(xiP)
+	tauP := sinXi / math.Sqrt(sinhEta*sinhEta+cosXi*cosXi)
+
+	// solve utmConformal(tau) = tauP by Newton's method
+	e2 := utmE * utmE
+	tau := tauP
+	for i := 0; i < 10; i++ {
+		t := utmConformal(tau)
+		d := (tauP - t) / math.Sqrt(1+t*t) * (1 + (1-e2)*tau*tau) / ((1 - e2) * math.Sqrt(1+tau*tau))
+		tau += d
+		if math.Abs(d) < 1e-12 {
+			break
+		}
+	}
+
+	lat = Coord(toDegrees(math.Atan(tau)))
+	lon = normalizeLongitude(toDegrees(math.Atan2(sinhEta, cosXi) + centralMeridian(u.Zone)))
+	return lat, lon
+}
+
+// parseZoneBand will parse a zone number and latitude band such as "31U"
+func parseZoneBand(s string) (int, byte, error) {
+	if len(s) < 2 {
+		return 0, 0, fmt.Errorf("invalid zone: %s", s)
+	}
+	zone, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || zone < 1 || zone > 60 {
+		return 0, 0, fmt.Errorf("invalid zone: %s", s)
+	}
+	band := strings.ToUpper(s[len(s)-1:])[0]
+	if strings.IndexByte(utmBands, band) == -1 {
+		return 0, 0, fmt.Errorf("invalid latitude band: %c", band)
+	}
+	return zone, band, nil
+}
+
+// ParseUTM will parse a position in the format of UTM.String
+func ParseUTM(s string) (UTM, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 3 {
+		return UTM{}, fmt.Errorf("invalid UTM position: %s", s)
+	}
+	zone, band, err := parseZoneBand(parts[0])
+	if err != nil {
+		return UTM{}, err
+	}
+	e, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return UTM{}, fmt.Errorf("parse easting: %s", err)
+	}
+	n, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return UTM{}, fmt.Errorf("parse northing: %s", err)
+	}
+	u := UTM{Zone: zone, Band: band, Easting: e, Northing: n}
+	return u, u.validate()
+}
+
+// validate will check that the zone and band are valid, and the easting and northing are within
+// the 100km squares of the grid
+func (u UTM) validate() error {
+	if u.Zone < 1 || u.Zone > 60 {
+		return fmt.Errorf("invalid zone: %d", u.Zone)
+	}
+	if strings.IndexByte(utmBands, u.Band) == -1 {
+		return fmt.Errorf("invalid latitude band: %q", u.Band)
+	}
+	if !(u.Easting >= 100e3 && u.Easting < 900e3) {
+		return fmt.Errorf("easting out of range: %g", u.Easting)
+	}
+	if !(u.Northing >= 0 && u.Northing < 10000e3) {
+		return fmt.Errorf("northing out of range: %g", u.Northing)
+	}
+	return nil
+}
+
+// MGRS 100km square letters. Columns repeat every 3 zones and rows every 2.
+var (
+	mgrsColumns = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+	mgrsRows    = [2]string{"ABCDEFGHJKLMNPQRSTUV", "FGHJKLMNPQRSTUVABCDE"}
+)
+
+// MGRS will return the position as an MGRS grid reference, e.g. "31U DQ 48251 11932". Precision
+// is the number of digits for each of easting and northing, from 0 (the 100km square) to 5 (1m).
+// The position is truncated, not rounded, so the reference is the square containing it. An error
+// is returned if the position is not a valid grid position.
+func (u UTM) MGRS(precision int) (string, error) {
+	if err := u.validate(); err != nil {
+		return "", err
+	}
+	if precision < 0 {
+		precision = 0
+	} else if precision > 5 {
+		precision = 5
+	}
+	col := int(math.Floor(u.Easting / 100e3))
+	row := int(math.Floor(u.Northing/100e3)) % 20
+	sq := string(mgrsColumns[(u.Zone-1)%3][col-1]) + string(mgrsRows[(u.Zone-1)%2][row])
+
+	res := fmt.Sprintf("%d%c %s", u.Zone, u.Band, sq)
+	if precision == 0 {
+		return res, nil
+	}
+	div := math.Pow10(5 - precision)
+	e := int(math.Floor(math.Mod(u.Easting, 100e3) / div))
+	n := int(math.Floor(math.Mod(u.Northing, 100e3) / div))
+	return fmt.Sprintf("%s %0*d %0*d", res, precision, e, precision, n), nil
+}
+
+// ParseMGRS will parse an MGRS grid reference, with or without spaces, and return the UTM
+// position of the southwest corner of the referenced square
+func ParseMGRS(s string) (UTM, error) {
+	ref := strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	i := 0
+	for i < len(ref) && ref[i] >= '0' && ref[i] <= '9' {
+		i++
+	}
+	if len(ref) < i+3 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference: %s", s)
+	}
+	zone, band, err := parseZoneBand(ref[:i+1])
+	if err != nil {
+		return UTM{}, err
+	}
+
+	col := strings.IndexByte(mgrsColumns[(zone-1)%3], ref[i+1])
+	row := strings.IndexByte(mgrsRows[(zone-1)%2], ref[i+2])
+	if col == -1 || row == -1 {
+		return UTM{}, fmt.Errorf("invalid 100km square: %s", ref[i+1:i+3])
+	}
+
+	digits := ref[i+3:]
+	if len(digits)%2 != 0 || len(digits) > 10 {
+		return UTM{}, fmt.Errorf("invalid MGRS reference: %s", s)
+	}
+	var e, n float64
+	if len(digits) > 0 {
+		p := len(digits) / 2
+		ev, err := strconv.Atoi(digits[:p])
+		if err != nil {
+			return UTM{}, fmt.Errorf("parse easting: %s", err)
+		}
+		nv, err := strconv.Atoi(digits[p:])
+		if err != nil {
+			return UTM{}, fmt.Errorf("parse northing: %s", err)
+		}
+		mul := math.Pow10(5 - p)
+		e, n = float64(ev)*mul, float64(nv)*mul
+	}
+
+	u := UTM{Zone: zone, Band: band, Easting: float64(col+1)*100e3 + e}
+
+	// rows repeat every 2000km, so take the first repetition north of the bottom of the band,
+	// allowing for the curvature of the parallel across the zone
+	bandLat := float64((strings.IndexByte(utmBands, band) - 10) * 8)
+	_, minNorthing := utmProject(toRadians(bandLat), 0)
+	if bandLat < 0 {
+		minNorthing += 10000e3
+	}
+	minNorthing -= 10e3
+	u.Northing = float64(row)*100e3 + n
+	for u.Northing < minNorthing {
+		u.Northing += 2000e3
+	}
+	return u, nil
+}
+
+// UTM will return the UTM position of the fix
+func (g GPGGA) UTM() (UTM, error) {
+	return UTMFromCoord(g.Latitude, g.Longitude)
+}
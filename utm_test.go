@@ -0,0 +1,123 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUTMFromCoord(t *testing.T) {
+	check := func(lat, lon Coord, zone int, band byte, e, n float64) {
+		u, err := UTMFromCoord(lat, lon)
+		assert.Nil(t, err)
+		assert.Equal(t, zone, u.Zone, "zone")
+		assert.Equal(t, string(band), string(u.Band), "band")
+		assert.InDelta(t, e, u.Easting, 0.01, "easting")
+		assert.InDelta(t, n, u.Northing, 0.01, "northing")
+
+		lat2, lon2 := u.Coord()
+		assert.InDelta(t, float64(lat), float64(lat2), 1e-9, "latitude")
+		assert.InDelta(t, float64(lon), float64(lon2), 1e-9, "longitude")
+	}
+
+	check(48.8582, 2.2945, 31, 'U', 448251.7951, 5411932.6781)
+	check(0, 0, 31, 'N', 166021.4431, 0)
+	check(-33.857, 151.215, 56, 'H', 334873.1988, 6252266.0918)
+	check(60.154, 5.5, 32, 'V', 305747.1315, 6673710.6038)  // southwest Norway
+	check(78.22, 15.65, 33, 'X', 514813.5273, 8683004.1533) // Svalbard
+	check(84, 0, 31, 'X', 465005.3449, 9329005.1825)
+
+	_, err := UTMFromCoord(84.1, 0)
+	assert.Equal(t, ErrOutsideUTM, err)
+	_, err = UTMFromCoord(-80.1, 0)
+	assert.Equal(t, ErrOutsideUTM, err)
+}
+
+func TestParseUTM(t *testing.T) {
+	u, err := ParseUTM("31U 448251 5411932")
+	assert.Nil(t, err)
+	assert.Equal(t, UTM{Zone: 31, Band: 'U', Easting: 448251, Northing: 5411932}, u)
+	assert.Equal(t, "31U 448251 5411932", u.String())
+	assert.True(t, u.North())
+
+	u, err = ParseUTM("56h 334873 6252266")
+	assert.Nil(t, err)
+	assert.False(t, u.North())
+	lat, lon := u.Coord()
+	assert.InDelta(t, -33.857, float64(lat), 1e-5)
+	assert.InDelta(t, 151.215, float64(lon), 1e-5)
+
+	for _, s := range []string{"", "31U 448251", "61U 448251 5411932", "31A 448251 5411932", "31U foo 5411932", "U 448251 5411932",
+		"31N 50000 0", "31N 950000 100", "31N 500000 -100", "31N 500000 10000000"} {
+		_, err = ParseUTM(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func assertMGRS(t *testing.T, exp string, u UTM, precision int) {
+	t.Helper()
+	ref, err := u.MGRS(precision)
+	assert.Nil(t, err)
+	assert.Equal(t, exp, ref)
+}
+
+func TestUTM_MGRS(t *testing.T) {
+	u, err := UTMFromCoord(48.8582, 2.2945)
+	assert.Nil(t, err)
+	assertMGRS(t, "31U DQ 48251 11932", u, 5)
+	assertMGRS(t, "31U DQ 4825 1193", u, 4)
+	assertMGRS(t, "31U DQ 48 11", u, 2)
+	assertMGRS(t, "31U DQ", u, 0)
+	assertMGRS(t, "31U DQ 48251 11932", u, 9)
+
+	u, err = UTMFromCoord(-33.857, 151.215)
+	assert.Nil(t, err)
+	assertMGRS(t, "56H LH 34873 52266", u, 5)
+
+	u, err = UTMFromCoord(78.22, 15.65)
+	assert.Nil(t, err)
+	assertMGRS(t, "33X WG 14813 83004", u, 5)
+
+	// outside the grid
+	for _, u := range []UTM{
+		{},
+		{Zone: 31, Band: 'N', Easting: 50000, Northing: 0},
+		{Zone: 31, Band: 'N', Easting: 950000, Northing: 100},
+		{Zone: 31, Band: 'N', Easting: 500000, Northing: -100},
+		{Zone: 31, Band: 'N', Easting: math.NaN(), Northing: 100},
+		{Zone: 61, Band: 'N', Easting: 500000, Northing: 100},
+		{Zone: 31, Band: 'A', Easting: 500000, Northing: 100},
+	} {
+		_, err = u.MGRS(5)
+		assert.NotNil(t, err, "%+v", u)
+	}
+}
+
+func TestParseMGRS(t *testing.T) {
+	check := func(ref string, exp UTM) {
+		u, err := ParseMGRS(ref)
+		assert.Nil(t, err, ref)
+		assert.Equal(t, exp, u, ref)
+	}
+	check("31U DQ 48251 11932", UTM{Zone: 31, Band: 'U', Easting: 448251, Northing: 5411932})
+	check("31UDQ4825111932", UTM{Zone: 31, Band: 'U', Easting: 448251, Northing: 5411932})
+	check("31u dq 48 11", UTM{Zone: 31, Band: 'U', Easting: 448000, Northing: 5411000})
+	check("31U DQ", UTM{Zone: 31, Band: 'U', Easting: 400000, Northing: 5400000})
+	check("56H LH 34873 52266", UTM{Zone: 56, Band: 'H', Easting: 334873, Northing: 6252266})
+	check("32V LM 05747 73710", UTM{Zone: 32, Band: 'V', Easting: 305747, Northing: 6673710})
+	check("33X WG 14813 83004", UTM{Zone: 33, Band: 'X', Easting: 514813, Northing: 8683004})
+	check("31N AA 66021 00000", UTM{Zone: 31, Band: 'N', Easting: 166021, Northing: 0})
+
+	for _, ref := range []string{"", "31U", "31U D", "31U DQ 4825 119", "31U DQ 482511 119321", "31U IQ 48251 11932", "31U DW 48251 11932", "31I DQ 48251 11932", "0U DQ 1 1", "31U DQ 4x251 11932"} {
+		_, err := ParseMGRS(ref)
+		assert.NotNil(t, err, ref)
+	}
+}
+
+func TestGPGGA_UTM(t *testing.T) {
+	g := GPGGA{Latitude: 48.1173, Longitude: 11.516667}
+	u, err := g.UTM()
+	assert.Nil(t, err)
+	assertMGRS(t, "32U PU 87299 32401", u, 5)
+}
@@ -0,0 +1,64 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// VHW reports the heading and speed of the vessel through the water
+type VHW struct {
+	Talker          string  `csv:"talker"`               // talker ID, defaults to VW (speed log) when serializing
+	HeadingTrue     float64 `csv:"heading_true_deg"`     // heading in degrees True, NaN if not reported
+	HeadingMagnetic float64 `csv:"heading_magnetic_deg"` // heading in degrees Magnetic, NaN if not reported
+	Speed           float64 `csv:"speed_kn"`             // speed through the water in knots
+}
+
+// Type returns TypeVHW to fulfill the Sentence interface
+func (v VHW) Type() Type {
+	return TypeVHW
+}
+
+// String will provide a NMEA formatted string. Speed is written in both knots and kilometers per hour
+func (v VHW) String() string {
+	return Raw{
+		TypeName: typeName(v.Talker, "VW", TypeVHW),
+		Fields: []string{
+			formatFieldOptFloat(v.HeadingTrue),
+			"T",
+			formatFieldOptFloat(v.HeadingMagnetic),
+			"M",
+			strconv.FormatFloat(v.Speed, 'f', -1, 64),
+			string(SpeedUnitKnots),
+			strconv.FormatFloat(ConvertSpeed(v.Speed, SpeedUnitKnots, SpeedUnitKilometersPerHour), 'f', 2, 64),
+			string(SpeedUnitKilometersPerHour),
+		},
+	}.String()
+}
+
+// Parse will parse VHW data from a raw sentence struct. If speed in knots is missing, it is calculated from kilometers per hour
+func (v *VHW) Parse(r *Raw) error {
+	var err error
+	v.Talker, err = parseTalker(r, TypeVHW)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 8 {
+		return fmt.Errorf("not enough fields, need at least 8")
+	}
+
+	v.HeadingTrue, err = parseFieldOptFloat(r.Fields[0], "HeadingTrue")
+	if err != nil {
+		return err
+	}
+	v.HeadingMagnetic, err = parseFieldOptFloat(r.Fields[2], "HeadingMagnetic")
+	if err != nil {
+		return err
+	}
+
+	v.Speed, err = parseFieldSpeed(r.Fields[4:8], "Speed")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const vhwStr = "$VWVHW,,T,,M,5.4,N,10.0,K*64"
+
+func TestVHW_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(vhwStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := new(VHW)
+	err = d.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeVHW, d.Type(), "type")
+	assert.True(t, math.IsNaN(d.HeadingTrue), "heading true")
+	assert.True(t, math.IsNaN(d.HeadingMagnetic), "heading magnetic")
+	assert.Equal(t, 5.4, d.Speed, "speed")
+}
+
+func TestVHW_String(t *testing.T) {
+	str := VHW{HeadingTrue: 181, HeadingMagnetic: 178.5, Speed: 6}.String()
+
+	assert.Equal(t, "$VWVHW,181,T,178.5,M,6,N,11.11,K*51", str)
+}
+
+func TestVHW_StringEmpty(t *testing.T) {
+	str := VHW{HeadingTrue: math.NaN(), HeadingMagnetic: math.NaN(), Speed: 6}.String()
+
+	assert.Equal(t, "$VWVHW,,T,,M,6,N,11.11,K*4C", str)
+}
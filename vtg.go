@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// VTG reports the course and speed over ground
+type VTG struct {
+	Talker         string   // talker ID, defaults to GP when serializing
+	TrueCourse     float64  // track made good in degrees True
+	MagneticCourse float64  // track made good in degrees Magnetic
+	Speed          float64  // speed over ground in knots
+	FixType        GPRMCFix // mode indicator, added in NMEA version 2.3
+}
+
+// Type returns TypeVTG to fulfill the Sentence interface
+func (v VTG) Type() Type {
+	return TypeVTG
+}
+
+// String will provide a NMEA formatted string. Speed is written in both knots and kilometers per hour
+func (v VTG) String() string {
+	return Raw{
+		TypeName: typeName(v.Talker, "GP", TypeVTG),
+		Fields: []string{
+			strconv.FormatFloat(v.TrueCourse, 'f', -1, 64),
+			"T",
+			strconv.FormatFloat(v.MagneticCourse, 'f', -1, 64),
+			"M",
+			strconv.FormatFloat(v.Speed, 'f', -1, 64),
+			string(SpeedUnitKnots),
+			strconv.FormatFloat(ConvertSpeed(v.Speed, SpeedUnitKnots, SpeedUnitKilometersPerHour), 'f', 2, 64),
+			string(SpeedUnitKilometersPerHour),
+			string(v.FixType),
+		},
+	}.String()
+}
+
+// Parse will parse VTG data from a raw sentence struct. If speed in knots is missing, it is calculated from kilometers per hour
+func (v *VTG) Parse(r *Raw) error {
+	var err error
+	v.Talker, err = parseTalker(r, TypeVTG)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 8 {
+		return fmt.Errorf("not enough fields, need at least 8")
+	}
+
+	v.TrueCourse, err = parseFieldFloat(r.Fields[0], "TrueCourse")
+	if err != nil {
+		return err
+	}
+	v.MagneticCourse, err = parseFieldFloat(r.Fields[2], "MagneticCourse")
+	if err != nil {
+		return err
+	}
+
+	v.Speed, err = parseFieldSpeed(r.Fields[4:8], "Speed")
+	if err != nil {
+		return err
+	}
+
+	v.FixType, err = parseFieldFixType(r.Fields, 8)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
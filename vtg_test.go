@@ -0,0 +1,36 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVTG_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(rawSentence))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := new(VTG)
+	err = v.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeVTG, v.Type(), "type")
+	assert.Equal(t, "GP", v.Talker, "talker")
+	assert.Equal(t, 230.17, v.TrueCourse, "true course")
+	assert.Zero(t, v.MagneticCourse, "magnetic course")
+	assert.Equal(t, 0.38, v.Speed, "speed")
+	assert.Equal(t, GPRMCFixDifferential, v.FixType)
+}
+
+func TestVTG_String(t *testing.T) {
+	str := VTG{
+		TrueCourse:     90,
+		MagneticCourse: 85,
+		Speed:          12.5,
+		FixType:        GPRMCFixAutonomous,
+	}.String()
+
+	assert.Equal(t, "$GPVTG,90,T,85,M,12.5,N,23.15,K,A*14", str)
+}
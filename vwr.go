@@ -0,0 +1,70 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// VWR reports the apparent wind angle and speed relative to the vessel. It is a legacy sentence superseded by MWV
+type VWR struct {
+	Talker string  `csv:"talker"`    // talker ID, defaults to II (integrated instrumentation) when serializing
+	Angle  float64 `csv:"angle_deg"` // wind angle in degrees off the bow, 0 to 180. Negative values are to port (L)
+	Speed  float64 `csv:"speed_kn"`  // wind speed in knots
+}
+
+// Type returns TypeVWR to fulfill the Sentence interface
+func (v VWR) Type() Type {
+	return TypeVWR
+}
+
+// String will provide a NMEA formatted string. Speed is written in knots, meters per second and kilometers per hour
+func (v VWR) String() string {
+	angle, side := v.Angle, "R"
+	if angle < 0 {
+		angle, side = -angle, "L"
+	}
+	return Raw{
+		TypeName: typeName(v.Talker, "II", TypeVWR),
+		Fields: []string{
+			strconv.FormatFloat(angle, 'f', -1, 64),
+			side,
+			strconv.FormatFloat(v.Speed, 'f', -1, 64),
+			string(SpeedUnitKnots),
+			strconv.FormatFloat(ConvertSpeed(v.Speed, SpeedUnitKnots, SpeedUnitMetersPerSecond), 'f', 2, 64),
+			string(SpeedUnitMetersPerSecond),
+			strconv.FormatFloat(ConvertSpeed(v.Speed, SpeedUnitKnots, SpeedUnitKilometersPerHour), 'f', 2, 64),
+			string(SpeedUnitKilometersPerHour),
+		},
+	}.String()
+}
+
+// Parse will parse VWR data from a raw sentence struct
+func (v *VWR) Parse(r *Raw) error {
+	var err error
+	v.Talker, err = parseTalker(r, TypeVWR)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 8 {
+		return fmt.Errorf("not enough fields, need at least 8")
+	}
+
+	v.Angle, err = parseFieldFloat(r.Fields[0], "Angle")
+	if err != nil {
+		return err
+	}
+	switch r.Fields[1] {
+	case "L":
+		v.Angle = -v.Angle
+	case "R", "":
+	default:
+		return fmt.Errorf("invalid direction for Angle: %s", r.Fields[1])
+	}
+
+	v.Speed, err = parseFieldSpeed(r.Fields[2:8], "Speed")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
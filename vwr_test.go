@@ -0,0 +1,30 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const vwrStr = "$IIVWR,75,R,1.0,N,0.51,M,1.85,K*6C"
+
+func TestVWR_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(vwrStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := new(VWR)
+	err = v.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeVWR, v.Type(), "type")
+	assert.Equal(t, 75.0, v.Angle, "angle")
+	assert.Equal(t, 1.0, v.Speed, "speed")
+}
+
+func TestVWR_String(t *testing.T) {
+	str := VWR{Angle: -30.5, Speed: 20}.String()
+
+	assert.Equal(t, "$IIVWR,30.5,L,20,N,10.29,M,37.04,K*47", str)
+}
@@ -0,0 +1,43 @@
+package nmea
+
+import (
+	"errors"
+	"math"
+)
+
+// TrueWind will calculate the true wind from the apparent wind angle (degrees clockwise from the bow) and speed,
+// given the boat speed and true heading. The returned direction is the direction the wind blows from in degrees True,
+// and speed is in the same unit as the apparent wind and boat speed.
+func TrueWind(angle, speed, boatSpeed, heading float64) (direction, trueSpeed float64) {
+	rad := angle * math.Pi / 180
+
+	// wind vector relative to the boat (x starboard, y forward) with the
+	// wind induced by the boat's own motion removed
+	x := speed * math.Sin(rad)
+	y := speed*math.Cos(rad) - boatSpeed
+
+	trueSpeed = math.Hypot(x, y)
+	if trueSpeed == 0 {
+		return 0, 0
+	}
+	return normalizeHeading(heading + math.Atan2(x, y)*180/math.Pi), trueSpeed
+}
+
+// TrueWindMWD will derive the true wind from an apparent (relative) MWV sentence, using the speed over ground
+// from VTG and the heading from HDT. Leeway and current are not accounted for.
+func TrueWindMWD(m MWV, v VTG, h HDT) (*MWD, error) {
+	if m.Reference != MWVReferenceRelative {
+		return nil, errors.New("MWV must be relative (apparent) wind")
+	}
+	if !m.Valid {
+		return nil, errors.New("MWV data is not valid")
+	}
+
+	dir, speed := TrueWind(m.Angle, m.Knots(), v.Speed, h.Heading)
+	return &MWD{
+		Talker:            m.Talker,
+		DirectionTrue:     dir,
+		DirectionMagnetic: math.NaN(),
+		Speed:             speed,
+	}, nil
+}
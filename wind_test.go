@@ -0,0 +1,37 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrueWind(t *testing.T) {
+	// all apparent wind from ahead is caused by the boat's motion
+	_, speed := TrueWind(0, 5, 5, 90)
+	assert.InDelta(t, 0, speed, epsilon)
+
+	// beam reach: 10 knots apparent at 90 degrees, boat at 10 knots heading north
+	dir, speed := TrueWind(90, 10, 10, 0)
+	assert.InDelta(t, 135, dir, epsilon)
+	assert.InDelta(t, 14.142136, speed, epsilon)
+
+	// stationary boat, apparent is true wind
+	dir, speed = TrueWind(300, 8, 0, 100)
+	assert.InDelta(t, 40, dir, epsilon)
+	assert.InDelta(t, 8, speed, epsilon)
+}
+
+func TestTrueWindMWD(t *testing.T) {
+	m := MWV{Angle: 90, Reference: MWVReferenceRelative, Speed: 10, Unit: SpeedUnitKnots, Valid: true}
+	res, err := TrueWindMWD(m, VTG{Speed: 10}, HDT{Heading: 0})
+	assert.Nil(t, err)
+	assert.InDelta(t, 135, res.DirectionTrue, epsilon)
+	assert.InDelta(t, 14.142136, res.Speed, epsilon)
+	assert.True(t, math.IsNaN(res.DirectionMagnetic))
+
+	m.Reference = MWVReferenceTheoretical
+	_, err = TrueWindMWD(m, VTG{Speed: 10}, HDT{Heading: 0})
+	assert.NotNil(t, err)
+}
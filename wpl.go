@@ -0,0 +1,54 @@
+package nmea
+
+import "fmt"
+
+// WPL reports the location of a waypoint
+type WPL struct {
+	Talker    string `csv:"talker"` // talker ID, defaults to GP when serializing
+	Latitude  Coord  `csv:"latitude_deg"`
+	Longitude Coord  `csv:"longitude_deg"`
+	Name      string `csv:"name"` // waypoint ID
+}
+
+// Type returns TypeWPL to fulfill the Sentence interface
+func (w WPL) Type() Type {
+	return TypeWPL
+}
+
+// String will provide a NMEA formatted string
+func (w WPL) String() string {
+	return Raw{
+		TypeName: typeName(w.Talker, "GP", TypeWPL),
+		Fields: []string{
+			w.Latitude.String(),
+			w.Latitude.Direction().LatString(),
+			w.Longitude.LonString(),
+			w.Longitude.Direction().LongString(),
+			w.Name,
+		},
+	}.String()
+}
+
+// Parse will parse WPL data from a raw sentence struct
+func (w *WPL) Parse(r *Raw) error {
+	var err error
+	w.Talker, err = parseTalker(r, TypeWPL)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	w.Latitude, err = parseFieldCoord(r.Fields[0], r.Fields[1], "latitude")
+	if err != nil {
+		return err
+	}
+	w.Longitude, err = parseFieldCoord(r.Fields[2], r.Fields[3], "longitude")
+	if err != nil {
+		return err
+	}
+
+	w.Name = r.Fields[4]
+	return nil
+}
@@ -0,0 +1,44 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const wplStr = "$GPWPL,4917.16,N,12310.64,W,003*65"
+
+func TestWPL_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(wplStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := new(WPL)
+	err = w.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeWPL, w.Type(), "type")
+	assert.InDelta(t, 49.286, float64(w.Latitude), epsilon, "latitude")
+	assert.Equal(t, "003", w.Name, "name")
+}
+
+func TestWPL_String(t *testing.T) {
+	str := WPL{Latitude: Coord(-45.51), Longitude: Coord(45.26), Name: "HOME"}.String()
+
+	assert.Equal(t, "$GPWPL,4530.6,S,04515.6,E,HOME*5E", str)
+}
+
+func TestWPL_LongitudeRoundTrip(t *testing.T) {
+	for _, lon := range []float64{0, 99.5, 100.5, 151.25, 179.99} {
+		for _, c := range []Coord{Coord(lon), Coord(-lon)} {
+			r, err := ParseRaw([]byte(WPL{Latitude: Coord(45.5), Longitude: c, Name: "WPT"}.String()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := new(WPL)
+			assert.Nil(t, w.Parse(r))
+			assert.InDelta(t, float64(c), float64(w.Longitude), epsilon, "lon %v", c)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// XDRTransducer is the type of transducer for an XDR measurement
+type XDRTransducer string
+
+// Transducer types for XDR
+const (
+	XDRTransducerAngular     XDRTransducer = "A" // angular displacement (e.g. pitch/roll) in degrees (D)
+	XDRTransducerTemperature XDRTransducer = "C" // temperature in degrees Celsius (C)
+	XDRTransducerDepth       XDRTransducer = "D" // linear displacement in meters (M)
+	XDRTransducerFrequency   XDRTransducer = "F" // frequency in Hertz (H)
+	XDRTransducerHumidity    XDRTransducer = "H" // relative humidity in percent (P)
+	XDRTransducerForce       XDRTransducer = "N" // force in Newtons (N)
+	XDRTransducerPressure    XDRTransducer = "P" // pressure in bars (B) or pascals (P)
+	XDRTransducerFlow        XDRTransducer = "R" // flow rate in liters per second (l)
+	XDRTransducerTachometer  XDRTransducer = "T" // rotational speed in RPM (R)
+	XDRTransducerVoltage     XDRTransducer = "U" // voltage in volts (V)
+	XDRTransducerCurrent     XDRTransducer = "I" // current in amperes (A)
+	XDRTransducerVolume      XDRTransducer = "V" // volume in cubic meters (M)
+	XDRTransducerSwitch      XDRTransducer = "S" // switch or valve state, no unit
+	XDRTransducerGeneric     XDRTransducer = "G" // generic value, no unit
+)
+
+// XDRMeasurement is a single measurement from an XDR sentence
+type XDRMeasurement struct {
+	Transducer XDRTransducer `csv:"transducer"`
+	Value      float64       `csv:"value"`
+	Unit       string        `csv:"unit"` // unit of Value, as it appears in the sentence
+	Name       string        `csv:"name"` // transducer name or ID
+}
+
+// xdrUnits maps the units of a transducer type to a factor and offset converting them to a common base unit
+var xdrUnits = map[XDRTransducer]map[string][2]float64{
+	XDRTransducerPressure: {
+		"B": {100000, 0},
+		"P": {1, 0},
+	},
+	XDRTransducerTemperature: {
+		"C": {1, 0},
+		"K": {1, -273.15},
+		"F": {5.0 / 9, -32 * 5.0 / 9},
+	},
+	XDRTransducerAngular: {
+		"D": {1, 0},
+	},
+	XDRTransducerDepth: {
+		"M": {1, 0},
+		"f": {0.3048, 0},
+		"F": {1.8288, 0},
+	},
+}
+
+// ValueIn will return the measurement value converted to unit. An error is returned if
+// the conversion is not known for the transducer type.
+func (m XDRMeasurement) ValueIn(unit string) (float64, error) {
+	if unit == m.Unit {
+		return m.Value, nil
+	}
+	units := xdrUnits[m.Transducer]
+	from, ok := units[m.Unit]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit for %s: %s", m.Name, m.Unit)
+	}
+	to, ok := units[unit]
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %s to unit %s", m.Name, unit)
+	}
+	base := m.Value*from[0] + from[1]
+	return (base - to[1]) / to[0], nil
+}
+
+// XDR reports measurements from one or more transducers
+type XDR struct {
+	Talker       string           `csv:"talker"` // talker ID, defaults to YX (transducer) when serializing
+	Measurements []XDRMeasurement `csv:"measurements"`
+}
+
+// Type returns TypeXDR to fulfill the Sentence interface
+func (x XDR) Type() Type {
+	return TypeXDR
+}
+
+// Lookup will return the first measurement with the given transducer name
+func (x XDR) Lookup(name string) (XDRMeasurement, bool) {
+	for _, m := range x.Measurements {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return XDRMeasurement{}, false
+}
+
+// String will provide a NMEA formatted string
+func (x XDR) String() string {
+	fields := make([]string, 0, 4*len(x.Measurements))
+	for _, m := range x.Measurements {
+		fields = append(fields,
+			string(m.Transducer),
+			strconv.FormatFloat(m.Value, 'f', -1, 64),
+			m.Unit,
+			m.Name,
+		)
+	}
+	return Raw{
+		TypeName: typeName(x.Talker, "YX", TypeXDR),
+		Fields:   fields,
+	}.String()
+}
+
+// Parse will parse XDR data from a raw sentence struct
+func (x *XDR) Parse(r *Raw) error {
+	var err error
+	x.Talker, err = parseTalker(r, TypeXDR)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 4 || len(r.Fields)%4 != 0 {
+		return fmt.Errorf("invalid number of fields, need groups of 4")
+	}
+
+	x.Measurements = make([]XDRMeasurement, 0, len(r.Fields)/4)
+	for i := 0; i < len(r.Fields); i += 4 {
+		m := XDRMeasurement{
+			Transducer: XDRTransducer(r.Fields[i]),
+			Unit:       r.Fields[i+2],
+			Name:       r.Fields[i+3],
+		}
+		m.Value, err = parseFieldFloat(r.Fields[i+1], "measurement "+m.Name)
+		if err != nil {
+			return err
+		}
+		x.Measurements = append(x.Measurements, m)
+	}
+
+	return nil
+}
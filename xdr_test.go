@@ -0,0 +1,53 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const xdrStr = "$YXXDR,C,19.52,C,TempAir,P,1.02481,B,Barometer,H,65.2,P,Humidity*41"
+
+func TestXDR_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(xdrStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := new(XDR)
+	err = x.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeXDR, x.Type(), "type")
+	assert.EqualValues(t, []XDRMeasurement{
+		{Transducer: XDRTransducerTemperature, Value: 19.52, Unit: "C", Name: "TempAir"},
+		{Transducer: XDRTransducerPressure, Value: 1.02481, Unit: "B", Name: "Barometer"},
+		{Transducer: XDRTransducerHumidity, Value: 65.2, Unit: "P", Name: "Humidity"},
+	}, x.Measurements)
+
+	m, ok := x.Lookup("Barometer")
+	assert.True(t, ok)
+	pa, err := m.ValueIn("P")
+	assert.Nil(t, err)
+	assert.InDelta(t, 102481, pa, epsilon, "pascals")
+
+	m, _ = x.Lookup("TempAir")
+	f, err := m.ValueIn("F")
+	assert.Nil(t, err)
+	assert.InDelta(t, 67.136, f, epsilon, "fahrenheit")
+
+	_, err = m.ValueIn("B")
+	assert.NotNil(t, err)
+
+	_, ok = x.Lookup("missing")
+	assert.False(t, ok)
+}
+
+func TestXDR_String(t *testing.T) {
+	str := XDR{Measurements: []XDRMeasurement{
+		{Transducer: XDRTransducerAngular, Value: -2.5, Unit: "D", Name: "PITCH"},
+		{Transducer: XDRTransducerVoltage, Value: 12.6, Unit: "V", Name: "BATT"},
+	}}.String()
+
+	assert.Equal(t, "$YXXDR,A,-2.5,D,PITCH,U,12.6,V,BATT*13", str)
+}
@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+// XTE reports the measured cross-track error
+type XTE struct {
+	Talker     string   `csv:"talker"`         // talker ID, defaults to GP when serializing
+	Valid      bool     `csv:"valid"`          // true if the data is reported as valid
+	CrossTrack float64  `csv:"cross_track_nm"` // cross-track error in nautical miles. Positive to steer right, negative to steer left
+	FixType    GPRMCFix `csv:"fix_type"`
+}
+
+// Type returns TypeXTE to fulfill the Sentence interface
+func (x XTE) Type() Type {
+	return TypeXTE
+}
+
+// String will provide a NMEA formatted string. Cross-track error is written in nautical miles
+func (x XTE) String() string {
+	xte, steer := formatFieldSteer(x.CrossTrack)
+	return Raw{
+		TypeName: typeName(x.Talker, "GP", TypeXTE),
+		Fields: []string{
+			formatFieldStatus(x.Valid),
+			formatFieldStatus(x.Valid),
+			xte,
+			steer,
+			"N",
+			string(x.FixType),
+		},
+	}.String()
+}
+
+// Parse will parse XTE data from a raw sentence struct. The data is only considered valid if both status fields are valid
+func (x *XTE) Parse(r *Raw) error {
+	var err error
+	x.Talker, err = parseTalker(r, TypeXTE)
+	if err != nil {
+		return err
+	}
+	if r.Fields == nil || len(r.Fields) < 5 {
+		return fmt.Errorf("not enough fields, need at least 5")
+	}
+
+	warn, err := parseFieldStatus(r.Fields[0], "status")
+	if err != nil {
+		return err
+	}
+	lock, err := parseFieldStatus(r.Fields[1], "cycle lock status")
+	if err != nil {
+		return err
+	}
+	x.Valid = warn && lock
+
+	x.CrossTrack, err = parseFieldSteer(r.Fields[2], r.Fields[3], "CrossTrack")
+	if err != nil {
+		return err
+	}
+	switch r.Fields[4] {
+	case "N", "":
+	case "K":
+		x.CrossTrack = x.CrossTrack * 1000 / 1852
+	default:
+		return fmt.Errorf("unknown unit for CrossTrack: %s", r.Fields[4])
+	}
+
+	x.FixType, err = parseFieldFixType(r.Fields, 5)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const xteStr = "$GPXTE,A,A,0.67,L,N*6F"
+
+func TestXTE_Parse(t *testing.T) {
+	r, err := ParseRaw([]byte(xteStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := new(XTE)
+	err = x.Parse(r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, TypeXTE, x.Type(), "type")
+	assert.True(t, x.Valid, "valid")
+	assert.Equal(t, -0.67, x.CrossTrack, "cross track")
+	assert.Equal(t, GPRMCFixUnspecified, x.FixType)
+}
+
+func TestXTE_String(t *testing.T) {
+	str := XTE{Valid: true, CrossTrack: 0.02, FixType: GPRMCFixAutonomous}.String()
+
+	assert.Equal(t, "$GPXTE,A,A,0.02,R,N,A*1F", str)
+}